@@ -34,6 +34,9 @@ func NewCommand(config *cli.Config) *cobra.Command {
 	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "required", true, "show Required column or section")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "show Sensitive column or section")
 	cmd.PersistentFlags().IntVar(&config.Settings.Indent, "indent", 2, "indention level of AsciiDoc sections [1, 2, 3, 4, 5]")
+	cmd.PersistentFlags().StringVar(&config.Settings.ValueFormat, "value-format", "json", "format of default and output values [json, hcl]")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Anchor, "anchor", false, "show anchors of inputs and outputs in document (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.TOC, "toc", false, "show table of contents in document (default false)")
 
 	// subcommands
 	cmd.AddCommand(document.NewCommand(config))
@@ -0,0 +1,36 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package confluence
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'confluence' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cobra.ExactArgs(1),
+		Use:         "confluence [PATH]",
+		Short:       "Generate Confluence storage format of inputs and outputs",
+		Annotations: cli.Annotations("confluence"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.RunEFunc(config),
+	}
+
+	// flags
+	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "required", true, "show Required column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "show Sensitive column and panel")
+	cmd.PersistentFlags().StringVar(&config.Settings.ValueFormat, "value-format", "json", "format of default and output values [json, hcl]")
+
+	return cmd
+}
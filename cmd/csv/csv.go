@@ -0,0 +1,35 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package csv
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'csv' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	options := &cli.CSVOptions{}
+	cmd := &cobra.Command{
+		Args:        cobra.ExactArgs(1),
+		Use:         "csv [PATH]",
+		Short:       "Generate CSV of inputs, outputs, resources and providers",
+		Annotations: cli.Annotations("csv"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.CSVRunEFunc(config, options),
+	}
+
+	// flags
+	cmd.PersistentFlags().BoolVar(&options.Recursive, "recursive", false, "include all the modules found in PATH, with their path as 'module' column (default false)")
+
+	return cmd
+}
@@ -0,0 +1,30 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package dot
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'graph dot' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cobra.ExactArgs(1),
+		Use:         "dot [PATH]",
+		Short:       "Generate Graphviz DOT graph of providers, resources and module calls",
+		Annotations: cli.Annotations("graph dot"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.RunEFunc(config),
+	}
+	return cmd
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package graph
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/cmd/graph/dot"
+	"github.com/terraform-docs/terraform-docs/cmd/graph/mermaid"
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'graph' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cobra.ExactArgs(1),
+		Use:         "graph [PATH]",
+		Short:       "Generate graph of providers, resources and module calls",
+		Annotations: cli.Annotations("graph"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.RunEFunc(config),
+	}
+
+	// subcommands
+	cmd.AddCommand(dot.NewCommand(config))
+	cmd.AddCommand(mermaid.NewCommand(config))
+
+	return cmd
+}
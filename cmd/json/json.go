@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/terraform-docs/terraform-docs/internal/cli"
+	"github.com/terraform-docs/terraform-docs/internal/schema"
 )
 
 // NewCommand returns a new cobra.Command for 'json' formatter
@@ -30,5 +31,8 @@ func NewCommand(config *cli.Config) *cobra.Command {
 	// flags
 	cmd.PersistentFlags().BoolVar(&config.Settings.Escape, "escape", true, "escape special characters")
 
+	cmd.PersistentFlags().IntVar(&config.Settings.SchemaVersion, "schema-version", schema.DefaultVersion, "version of the output schema [1, 2]")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DataFlow, "data-flow", false, "include data flow between items of the module (default false)")
+
 	return cmd
 }
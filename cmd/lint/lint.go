@@ -0,0 +1,33 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package lint
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'lint' command
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Use:   "lint [PATH]",
+		Short: "Check the module for unused variables",
+		Annotations: map[string]string{
+			"command": "lint",
+			"kind":    "validator",
+		},
+		PreRunE: cli.PreRunEFunc(config),
+		RunE:    cli.LintRunEFunc(config),
+	}
+	return cmd
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package install
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+	"github.com/terraform-docs/terraform-docs/internal/plugin"
+)
+
+// NewCommand returns a new cobra.Command for 'plugin install' command
+func NewCommand(config *cli.Config) *cobra.Command {
+	options := &cli.InstallOptions{}
+	cmd := &cobra.Command{
+		Args:  cobra.MaximumNArgs(1),
+		Use:   "install [SOURCE]",
+		Short: "Install a plugin from a file, an archive or a URL",
+		Long: "Install a plugin from a file, an archive or a URL. If SOURCE is not provided\n" +
+			"all the plugins declared in 'plugins' of config file are installed.",
+		RunE: cli.InstallRunEFunc(config, options),
+	}
+
+	cmd.Flags().StringVar(&options.Dir, "dir", plugin.LocalDir(), "directory to install plugin into")
+	cmd.Flags().StringVar(&options.SHA256, "sha256", "", "expected SHA256 checksum of plugin binary, required for URL")
+
+	return cmd
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package list
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+	"github.com/terraform-docs/terraform-docs/internal/plugin"
+)
+
+// NewCommand returns a new cobra.Command for 'plugin list' command
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "list",
+		Short: "List discovered plugins in the order of precedence",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cli.ReadConfig(config); err != nil {
+				return err
+			}
+			plugins, err := cli.DiscoverPlugins(config)
+			if err != nil {
+				return err
+			}
+			defer plugins.Clean()
+
+			// start all the plugins to retrieve their versions
+			plugins.All()
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tVERSION\tPROTOCOL\tPATH\tSTATUS")
+			for _, p := range plugins.Plugins() {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", orNone(p.Name), orNone(p.Version), protocol(p), p.Path, status(p))
+			}
+			return w.Flush()
+		},
+	}
+	return cmd
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func protocol(p *plugin.Plugin) string {
+	if p.Protocol == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d", p.Protocol)
+}
+
+func status(p *plugin.Plugin) string {
+	switch {
+	case p.Err != nil:
+		return fmt.Sprintf("error: %s", p.Err)
+	case p.Shadowed:
+		return "shadowed"
+	}
+	return "ok"
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package plugin
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/cmd/plugin/install"
+	"github.com/terraform-docs/terraform-docs/cmd/plugin/list"
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'plugin' command
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "plugin",
+		Short: "Manage formatter plugins",
+	}
+
+	// subcommands
+	cmd.AddCommand(install.NewCommand(config))
+	cmd.AddCommand(list.NewCommand(config))
+
+	return cmd
+}
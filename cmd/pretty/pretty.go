@@ -29,6 +29,7 @@ func NewCommand(config *cli.Config) *cobra.Command {
 
 	// flags
 	cmd.PersistentFlags().BoolVar(&config.Settings.Color, "color", true, "colorize printed result")
+	cmd.PersistentFlags().StringVar(&config.Settings.ValueFormat, "value-format", "json", "format of default and output values [json, hcl]")
 
 	return cmd
 }
@@ -17,11 +17,23 @@ import (
 
 	"github.com/terraform-docs/terraform-docs/cmd/asciidoc"
 	"github.com/terraform-docs/terraform-docs/cmd/completion"
+	"github.com/terraform-docs/terraform-docs/cmd/confluence"
+	"github.com/terraform-docs/terraform-docs/cmd/csv"
+	"github.com/terraform-docs/terraform-docs/cmd/graph"
+	"github.com/terraform-docs/terraform-docs/cmd/hcl"
+	"github.com/terraform-docs/terraform-docs/cmd/html"
 	"github.com/terraform-docs/terraform-docs/cmd/json"
+	"github.com/terraform-docs/terraform-docs/cmd/lint"
 	"github.com/terraform-docs/terraform-docs/cmd/markdown"
+	"github.com/terraform-docs/terraform-docs/cmd/plugin"
 	"github.com/terraform-docs/terraform-docs/cmd/pretty"
+	"github.com/terraform-docs/terraform-docs/cmd/rst"
+	"github.com/terraform-docs/terraform-docs/cmd/schema"
+	"github.com/terraform-docs/terraform-docs/cmd/site"
+	"github.com/terraform-docs/terraform-docs/cmd/template"
 	"github.com/terraform-docs/terraform-docs/cmd/tfvars"
 	"github.com/terraform-docs/terraform-docs/cmd/toml"
+	"github.com/terraform-docs/terraform-docs/cmd/tsv"
 	"github.com/terraform-docs/terraform-docs/cmd/version"
 	"github.com/terraform-docs/terraform-docs/cmd/xml"
 	"github.com/terraform-docs/terraform-docs/cmd/yaml"
@@ -57,8 +69,8 @@ func NewCommand() *cobra.Command {
 	// flags
 	cmd.PersistentFlags().StringVarP(&config.File, "config", "c", ".terraform-docs.yml", "config file name")
 
-	cmd.PersistentFlags().StringSliceVar(&config.Sections.Show, "show", []string{}, "show section [header, inputs, modules, outputs, providers, requirements, resources]")
-	cmd.PersistentFlags().StringSliceVar(&config.Sections.Hide, "hide", []string{}, "hide section [header, inputs, modules, outputs, providers, requirements, resources]")
+	cmd.PersistentFlags().StringSliceVar(&config.Sections.Show, "show", []string{}, "show section [data-flow, diagram, header, inputs, modules, outputs, providers, requirements, resources, usage]")
+	cmd.PersistentFlags().StringSliceVar(&config.Sections.Hide, "hide", []string{}, "hide section [data-flow, diagram, header, inputs, modules, outputs, providers, requirements, resources, usage]")
 	cmd.PersistentFlags().BoolVar(&config.Sections.ShowAll, "show-all", true, "show all sections")
 	cmd.PersistentFlags().BoolVar(&config.Sections.HideAll, "hide-all", false, "hide all sections (default false)")
 
@@ -67,23 +79,44 @@ func NewCommand() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(&config.Sort.By.Type, "sort-by-type", false, "sort items by type of them (default false)")
 
 	cmd.PersistentFlags().StringVar(&config.HeaderFrom, "header-from", "main.tf", "relative path of a file to read header from")
+	cmd.PersistentFlags().StringVar(&config.TemplateDir, "template-dir", "", "relative path of a directory to read '.tmpl' files overriding templates from (default \"\")")
 
 	cmd.PersistentFlags().BoolVar(&config.OutputValues.Enabled, "output-values", false, "inject output values into outputs (default false)")
 	cmd.PersistentFlags().StringVar(&config.OutputValues.From, "output-values-from", "", "inject output values from file into outputs (default \"\")")
+	cmd.PersistentFlags().BoolVar(&config.Settings.InputReferences, "input-references", false, "show items of the module referencing each input, and flag unused ones (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.OutputSources, "output-sources", false, "show inputs, resources and module outputs each output is derived from (default false)")
+
+	cmd.PersistentFlags().BoolVar(&config.Usage.Enabled, "usage", false, "show usage of the module as a 'module' block calling it (default false)")
+	cmd.PersistentFlags().StringVar(&config.Usage.Name, "usage-name", "", "name of the module in usage (default name of its directory)")
+	cmd.PersistentFlags().StringVar(&config.Usage.Source, "usage-source", "", "source of the module in usage (default inferred from git remote)")
+	cmd.PersistentFlags().StringVar(&config.Usage.Version, "usage-version", "", "version of the module in usage (default latest git tag)")
+	cmd.PersistentFlags().BoolVar(&config.Usage.Optional, "usage-optional", false, "show optional inputs commented out in usage (default false)")
 
 	// formatter subcommands
 	cmd.AddCommand(asciidoc.NewCommand(config))
+	cmd.AddCommand(confluence.NewCommand(config))
+	cmd.AddCommand(csv.NewCommand(config))
+	cmd.AddCommand(graph.NewCommand(config))
+	cmd.AddCommand(hcl.NewCommand(config))
+	cmd.AddCommand(html.NewCommand(config))
 	cmd.AddCommand(json.NewCommand(config))
 	cmd.AddCommand(markdown.NewCommand(config))
 	cmd.AddCommand(pretty.NewCommand(config))
+	cmd.AddCommand(rst.NewCommand(config))
 	cmd.AddCommand(tfvars.NewCommand(config))
 	cmd.AddCommand(toml.NewCommand(config))
+	cmd.AddCommand(tsv.NewCommand(config))
 	cmd.AddCommand(xml.NewCommand(config))
 	cmd.AddCommand(yaml.NewCommand(config))
 
 	// other subcommands
 	cmd.AddCommand(completion.NewCommand())
-	cmd.AddCommand(version.NewCommand())
+	cmd.AddCommand(lint.NewCommand(config))
+	cmd.AddCommand(plugin.NewCommand(config))
+	cmd.AddCommand(schema.NewCommand())
+	cmd.AddCommand(site.NewCommand(config))
+	cmd.AddCommand(template.NewCommand())
+	cmd.AddCommand(version.NewCommand(config))
 
 	return cmd
 }
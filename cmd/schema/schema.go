@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/schema"
+)
+
+// NewCommand returns a new cobra.Command for 'schema' command
+func NewCommand() *cobra.Command {
+	var version int
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "schema",
+		Short: "Print JSON Schema of the structured (e.g. json, yaml) outputs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			document, err := schema.Generate(version)
+			if err != nil {
+				return err
+			}
+			fmt.Println(document)
+			return nil
+		},
+	}
+
+	// flags
+	cmd.Flags().IntVar(&version, "schema-version", schema.LatestVersion, "version of the output schema [1, 2]")
+
+	return cmd
+}
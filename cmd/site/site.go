@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package site
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+	"github.com/terraform-docs/terraform-docs/internal/site"
+)
+
+// NewCommand returns a new cobra.Command for 'site' command
+func NewCommand(config *cli.Config) *cobra.Command {
+	options := &cli.SiteOptions{}
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(2),
+		Use:   "site [ROOT] [OUTDIR]",
+		Short: "Generate documentation site of all the modules in a catalog",
+		Annotations: map[string]string{
+			"command": "site",
+			"kind":    "generator",
+		},
+		PreRunE: cli.PreRunEFunc(config),
+		RunE:    cli.SiteRunEFunc(config, options),
+	}
+
+	// flags
+	cmd.PersistentFlags().StringVar(&options.Format, "format", "markdown", fmt.Sprintf("format of the pages [%s]", strings.Join(site.Formats(), ", ")))
+
+	return cmd
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package funcs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/format"
+	"github.com/terraform-docs/terraform-docs/internal/template"
+)
+
+// NewCommand returns a new cobra.Command for 'template funcs' command
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "funcs",
+		Short: "List functions available in templates with their signatures",
+		Run: func(cmd *cobra.Command, args []string) {
+			for _, f := range template.Functions() {
+				fmt.Printf("%s\n    %s\n\n", f.Signature, f.Description)
+			}
+			fmt.Printf("Functions available only in templates of some formatters:\n\n")
+			for _, f := range format.Functions() {
+				fmt.Printf("%s\n    %s\n    formatters: %s\n\n", f.Signature, f.Description, strings.Join(f.Formatters, ", "))
+			}
+		},
+	}
+	return cmd
+}
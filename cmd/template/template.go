@@ -0,0 +1,31 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package template
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/cmd/template/funcs"
+)
+
+// NewCommand returns a new cobra.Command for 'template' command
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "template",
+		Short: "Inspect templates used by formatters",
+	}
+
+	// subcommands
+	cmd.AddCommand(funcs.NewCommand())
+
+	return cmd
+}
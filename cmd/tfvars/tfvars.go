@@ -15,6 +15,8 @@ import (
 
 	"github.com/terraform-docs/terraform-docs/cmd/tfvars/hcl"
 	"github.com/terraform-docs/terraform-docs/cmd/tfvars/json"
+	"github.com/terraform-docs/terraform-docs/cmd/tfvars/schema"
+	"github.com/terraform-docs/terraform-docs/cmd/tfvars/validate"
 	"github.com/terraform-docs/terraform-docs/internal/cli"
 )
 
@@ -30,6 +32,8 @@ func NewCommand(config *cli.Config) *cobra.Command {
 	// subcommands
 	cmd.AddCommand(hcl.NewCommand(config))
 	cmd.AddCommand(json.NewCommand(config))
+	cmd.AddCommand(schema.NewCommand(config))
+	cmd.AddCommand(validate.NewCommand(config))
 
 	return cmd
 }
@@ -0,0 +1,33 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package validate
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'tfvars validate' command
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.RangeArgs(1, 2),
+		Use:   "validate FILE [PATH]",
+		Short: "Validate a tfvars file against inputs of the module",
+		Annotations: map[string]string{
+			"command": "tfvars validate",
+			"kind":    "validator",
+		},
+		PreRunE: cli.ValidatePreRunEFunc(config),
+		RunE:    cli.ValidateRunEFunc(config),
+	}
+	return cmd
+}
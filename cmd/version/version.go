@@ -15,22 +15,26 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/terraform-docs/terraform-docs/internal/plugin"
+	"github.com/terraform-docs/terraform-docs/internal/cli"
 	"github.com/terraform-docs/terraform-docs/internal/version"
 )
 
 // NewCommand returns a new cobra.Command for 'version' command
-func NewCommand() *cobra.Command {
+func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
 		Args:  cobra.NoArgs,
 		Use:   "version",
 		Short: "Print the version number of terraform-docs",
 		Run: func(cmd *cobra.Command, args []string) {
 			fmt.Printf("terraform-docs version %s\n", Full())
-			plugins, err := plugin.Discover()
+			if err := cli.ReadConfig(config); err != nil {
+				return
+			}
+			plugins, err := cli.DiscoverPlugins(config)
 			if err != nil {
 				return
 			}
+			defer plugins.Clean()
 			for _, f := range plugins.All() {
 				name, err := f.Name()
 				if err != nil {
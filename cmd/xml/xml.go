@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/terraform-docs/terraform-docs/internal/cli"
+	"github.com/terraform-docs/terraform-docs/internal/schema"
 )
 
 // NewCommand returns a new cobra.Command for 'xml' formatter
@@ -26,5 +27,9 @@ func NewCommand(config *cli.Config) *cobra.Command {
 		PreRunE:     cli.PreRunEFunc(config),
 		RunE:        cli.RunEFunc(config),
 	}
+
+	// flags
+	cmd.PersistentFlags().IntVar(&config.Settings.SchemaVersion, "schema-version", schema.DefaultVersion, "version of the output schema [1, 2]")
+
 	return cmd
 }
@@ -5,6 +5,7 @@ go 1.16
 require (
 	github.com/BurntSushi/toml v0.3.1
 	github.com/hashicorp/go-plugin v1.4.0
+	github.com/hashicorp/hcl/v2 v2.0.0
 	github.com/iancoleman/orderedmap v0.2.0
 	github.com/imdario/mergo v0.3.11
 	github.com/mitchellh/go-homedir v1.1.0
@@ -13,6 +14,7 @@ require (
 	github.com/stretchr/testify v1.7.0
 	github.com/terraform-docs/plugin-sdk v0.1.0
 	github.com/terraform-docs/terraform-config-inspect v0.0.0-20210126151735-6ef25af8884f
+	github.com/zclconf/go-cty v1.1.0
 	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b
 	honnef.co/go/tools v0.1.2
 	mvdan.cc/xurls/v2 v2.2.0
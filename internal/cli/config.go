@@ -12,8 +12,12 @@ package cli
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 
 	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/schema"
 	"github.com/terraform-docs/terraform-docs/internal/terraform"
 )
 
@@ -30,6 +34,11 @@ type sections struct {
 	providers    bool `yaml:"-"`
 	requirements bool `yaml:"-"`
 	resources    bool `yaml:"-"`
+
+	// opt-in sections, which are not shown by 'show-all'
+	dataflow bool `yaml:"-"`
+	diagram  bool `yaml:"-"`
+	usage    bool `yaml:"-"`
 }
 
 func defaultSections() sections {
@@ -46,22 +55,22 @@ func defaultSections() sections {
 		providers:    false,
 		requirements: false,
 		resources:    false,
+
+		dataflow: false,
+		diagram:  false,
+		usage:    false,
 	}
 }
 
 func (s *sections) validate() error {
-	items := []string{"header", "inputs", "modules", "outputs", "providers", "requirements", "resources"}
+	items := []string{"data-flow", "diagram", "header", "inputs", "modules", "outputs", "providers", "requirements", "resources", "usage"}
 	for _, item := range s.Show {
-		switch item {
-		case items[0], items[1], items[2], items[3], items[4], items[5], items[6]:
-		default:
+		if !contains(items, item) {
 			return fmt.Errorf("'%s' is not a valid section", item)
 		}
 	}
 	for _, item := range s.Hide {
-		switch item {
-		case items[0], items[1], items[2], items[3], items[4], items[5], items[6]:
-		default:
+		if !contains(items, item) {
 			return fmt.Errorf("'%s' is not a valid section", item)
 		}
 	}
@@ -99,6 +108,16 @@ func (s *sections) visibility(section string) bool {
 	return false
 }
 
+// optin returns visibility of opt-in 'section', which is shown if it's 'enabled'
+// (e.g. with its own flag) or if it's listed in 'show', unless it's listed in
+// 'hide'. Unlike the other sections it's not shown by 'show-all'.
+func (s *sections) optin(section string, enabled bool) bool {
+	if contains(s.Hide, section) {
+		return false
+	}
+	return enabled || contains(s.Show, section)
+}
+
 type outputvalues struct {
 	Enabled bool   `yaml:"enabled"`
 	From    string `yaml:"from"`
@@ -121,6 +140,31 @@ func (o *outputvalues) validate() error {
 	return nil
 }
 
+type usage struct {
+	Enabled  bool   `yaml:"enabled"`
+	Name     string `yaml:"name"`
+	Source   string `yaml:"source"`
+	Version  string `yaml:"version"`
+	Optional bool   `yaml:"optional"`
+}
+
+func defaultUsage() usage {
+	return usage{
+		Enabled:  false,
+		Name:     "",
+		Source:   "",
+		Version:  "",
+		Optional: false,
+	}
+}
+
+func (u *usage) validate() error {
+	if u.Name != "" && !hclsyntax.ValidIdentifier(u.Name) {
+		return fmt.Errorf("value of '--usage-name' must be a valid identifier")
+	}
+	return nil
+}
+
 type sortby struct {
 	Required bool `name:"required"`
 	Type     bool `name:"type"`
@@ -158,48 +202,93 @@ func (s *sort) validate() error {
 }
 
 type settings struct {
-	Color     bool `yaml:"color"`
-	Escape    bool `yaml:"escape"`
-	Indent    int  `yaml:"indent"`
-	Required  bool `yaml:"required"`
-	Sensitive bool `yaml:"sensitive"`
+	Anchor            bool   `yaml:"anchor"`
+	Color             bool   `yaml:"color"`
+	DataFlow          bool   `yaml:"data-flow"`
+	Diagram           bool   `yaml:"diagram"`
+	Escape            bool   `yaml:"escape"`
+	Indent            int    `yaml:"indent"`
+	InputReferences   bool   `yaml:"input-references"`
+	OutputSources     bool   `yaml:"output-sources"`
+	Required          bool   `yaml:"required"`
+	SchemaVersion     int    `yaml:"schema-version"`
+	Sensitive         bool   `yaml:"sensitive"`
+	TfvarsDescription bool   `yaml:"tfvars-description"`
+	TOC               bool   `yaml:"toc"`
+	ValueFormat       string `yaml:"value-format"`
 }
 
 func defaultSettings() settings {
 	return settings{
-		Color:     true,
-		Escape:    true,
-		Indent:    2,
-		Required:  true,
-		Sensitive: true,
+		Anchor:            false,
+		Color:             true,
+		DataFlow:          false,
+		Diagram:           false,
+		Escape:            true,
+		Indent:            2,
+		InputReferences:   false,
+		OutputSources:     false,
+		Required:          true,
+		SchemaVersion:     schema.DefaultVersion,
+		Sensitive:         true,
+		TfvarsDescription: false,
+		TOC:               false,
+		ValueFormat:       "json",
 	}
 }
 
 func (s *settings) validate() error {
+	if !schema.IsSupported(s.SchemaVersion) {
+		return fmt.Errorf("value of '--schema-version' can only be one of %v", schema.Versions())
+	}
+	formats := []string{"json", "hcl"}
+	if !contains(formats, s.ValueFormat) {
+		return fmt.Errorf("value of '--value-format' can only be one of %v", formats)
+	}
+	return nil
+}
+
+type pluginspec struct {
+	Source string `yaml:"source"`
+	SHA256 string `yaml:"sha256"`
+}
+
+func (p *pluginspec) validate(name string) error {
+	if len(p.SHA256) != 64 || strings.Trim(strings.ToLower(p.SHA256), "0123456789abcdef") != "" {
+		return fmt.Errorf("value of 'plugins.%s.sha256' must be a SHA256 checksum in hex", name)
+	}
 	return nil
 }
 
 // Config represents all the available config options that can be accessed and passed through CLI
 type Config struct {
-	File         string       `yaml:"-"`
-	Formatter    string       `yaml:"formatter"`
-	HeaderFrom   string       `yaml:"header-from"`
-	Sections     sections     `yaml:"sections"`
-	OutputValues outputvalues `yaml:"output-values"`
-	Sort         sort         `yaml:"sort"`
-	Settings     settings     `yaml:"settings"`
+	File          string                 `yaml:"-"`
+	Formatter     string                 `yaml:"formatter"`
+	HeaderFrom    string                 `yaml:"header-from"`
+	TemplateDir   string                 `yaml:"template-dir"`
+	Sections      sections               `yaml:"sections"`
+	OutputValues  outputvalues           `yaml:"output-values"`
+	Usage         usage                  `yaml:"usage"`
+	Sort          sort                   `yaml:"sort"`
+	Settings      settings               `yaml:"settings"`
+	Plugins       map[string]pluginspec  `yaml:"plugins"`
+	PluginOptions map[string]interface{} `yaml:"plugin-options"`
 }
 
 // DefaultConfig returns new instance of Config with default values set
 func DefaultConfig() *Config {
 	return &Config{
-		File:         "",
-		Formatter:    "",
-		HeaderFrom:   "main.tf",
-		Sections:     defaultSections(),
-		OutputValues: defaultOutputValues(),
-		Sort:         defaultSort(),
-		Settings:     defaultSettings(),
+		File:          "",
+		Formatter:     "",
+		HeaderFrom:    "main.tf",
+		TemplateDir:   "",
+		Sections:      defaultSections(),
+		OutputValues:  defaultOutputValues(),
+		Usage:         defaultUsage(),
+		Sort:          defaultSort(),
+		Settings:      defaultSettings(),
+		Plugins:       map[string]pluginspec{},
+		PluginOptions: map[string]interface{}{},
 	}
 }
 
@@ -219,6 +308,9 @@ func (c *Config) process() {
 	c.Sections.providers = c.Sections.visibility("providers")
 	c.Sections.requirements = c.Sections.visibility("requirements")
 	c.Sections.resources = c.Sections.visibility("resources")
+	c.Sections.dataflow = c.Sections.optin("data-flow", c.Settings.DataFlow)
+	c.Sections.diagram = c.Sections.optin("diagram", c.Settings.Diagram)
+	c.Sections.usage = c.Sections.optin("usage", c.Usage.Enabled)
 }
 
 // validate config and check for any misuse or misconfiguration
@@ -243,6 +335,11 @@ func (c *Config) validate() error {
 		return err
 	}
 
+	// usage
+	if err := c.Usage.validate(); err != nil {
+		return err
+	}
+
 	// sort
 	if err := c.Sort.validate(); err != nil {
 		return err
@@ -253,6 +350,13 @@ func (c *Config) validate() error {
 		return err
 	}
 
+	// plugins
+	for name, p := range c.Plugins {
+		if err := p.validate(name); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -279,6 +383,13 @@ func (c *Config) extract() (*print.Settings, *terraform.Options) {
 	options.OutputValues = c.OutputValues.Enabled
 	options.OutputValuesPath = c.OutputValues.From
 
+	// usage
+	options.ShowUsage = c.Sections.usage
+	options.Usage.Name = c.Usage.Name
+	options.Usage.Source = c.Usage.Source
+	options.Usage.Version = c.Usage.Version
+	options.Usage.Optional = c.Usage.Optional
+
 	// sort
 	settings.SortByName = c.Sort.Enabled
 	settings.SortByRequired = c.Sort.Enabled && c.Sort.By.Required
@@ -290,9 +401,21 @@ func (c *Config) extract() (*print.Settings, *terraform.Options) {
 	// settings
 	settings.EscapeCharacters = c.Settings.Escape
 	settings.IndentLevel = c.Settings.Indent
+	settings.SchemaVersion = c.Settings.SchemaVersion
+	settings.ShowAnchor = c.Settings.Anchor
 	settings.ShowColor = c.Settings.Color
+	settings.ShowDataFlow = c.Sections.dataflow
+	settings.ShowDiagram = c.Sections.diagram
+	settings.ShowInputReferences = c.Settings.InputReferences
+	settings.ShowOutputSources = c.Settings.OutputSources
 	settings.ShowRequired = c.Settings.Required
 	settings.ShowSensitivity = c.Settings.Sensitive
+	settings.ShowTOC = c.Settings.TOC
+	settings.TfvarsDescription = c.Settings.TfvarsDescription
+	settings.ValueFormat = c.Settings.ValueFormat
+	options.DataFlow = settings.ShowDataFlow
+	options.OutputSources = settings.ShowOutputSources
+	options.InputReferences = settings.ShowInputReferences
 
 	return settings, options
 }
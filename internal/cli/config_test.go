@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigOptinSections(t *testing.T) {
+	tests := []struct {
+		name     string
+		show     []string
+		hide     []string
+		showall  bool
+		enabled  bool
+		expected bool
+	}{
+		{
+			name:     "opt-in section not shown by show-all",
+			showall:  true,
+			enabled:  false,
+			expected: false,
+		},
+		{
+			name:     "opt-in section enabled with show-all",
+			showall:  true,
+			enabled:  true,
+			expected: true,
+		},
+		{
+			name:     "opt-in section enabled and hidden",
+			hide:     []string{"diagram"},
+			showall:  true,
+			enabled:  true,
+			expected: false,
+		},
+		{
+			name:     "opt-in section shown with hide-all",
+			show:     []string{"inputs", "diagram"},
+			showall:  false,
+			enabled:  false,
+			expected: true,
+		},
+		{
+			name:     "opt-in section enabled with hide-all",
+			show:     []string{"inputs"},
+			showall:  false,
+			enabled:  true,
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := DefaultConfig()
+			config.Sections.Show = tt.show
+			config.Sections.Hide = tt.hide
+			config.Sections.ShowAll = tt.showall
+			config.Settings.Diagram = tt.enabled
+			config.process()
+
+			assert.Nil(config.Sections.validate())
+			settings, _ := config.extract()
+			assert.Equal(tt.expected, settings.ShowDiagram)
+		})
+	}
+}
+
+func TestConfigInvalidSection(t *testing.T) {
+	assert := assert.New(t)
+
+	config := DefaultConfig()
+	config.Sections.Show = []string{"foo"}
+	config.Sections.ShowAll = false
+	config.process()
+
+	assert.EqualError(config.Sections.validate(), "'foo' is not a valid section")
+}
@@ -63,7 +63,7 @@ func (c *cfgreader) parse() error {
 		}
 
 		switch flag {
-		case "header-from":
+		case "header-from", "template-dir":
 			if err := c.overrideValue(flag, c.config, &c.overrides); err != nil {
 				return err
 			}
@@ -93,7 +93,17 @@ func (c *cfgreader) parse() error {
 			if err := c.overrideValue(mapping[flag], &c.config.OutputValues, &c.overrides.OutputValues); err != nil {
 				return err
 			}
-		case "color", "escape", "indent", "required", "sensitive":
+		case "usage", "usage-name", "usage-source", "usage-version", "usage-optional":
+			mapping := map[string]string{"usage": "enabled", "usage-name": "name", "usage-source": "source", "usage-version": "version", "usage-optional": "optional"}
+			if err := c.overrideValue(mapping[flag], &c.config.Usage, &c.overrides.Usage); err != nil {
+				return err
+			}
+		case "description":
+			// flag of 'tfvars hcl' which is scoped in config file
+			if err := c.overrideValue("tfvars-description", &c.config.Settings, &c.overrides.Settings); err != nil {
+				return err
+			}
+		case "anchor", "color", "data-flow", "diagram", "escape", "indent", "input-references", "output-sources", "required", "schema-version", "sensitive", "toc", "value-format":
 			if err := c.overrideValue(flag, &c.config.Settings, &c.overrides.Settings); err != nil {
 				return err
 			}
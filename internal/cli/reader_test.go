@@ -11,6 +11,9 @@ the root directory of this source tree.
 package cli
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -166,6 +169,32 @@ func TestOverrideValue(t *testing.T) {
 	}
 }
 
+func TestParseTfvarsDescription(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "tfdocs-config")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, ".terraform-docs.yml")
+	assert.Nil(ioutil.WriteFile(file, []byte("settings:\n  tfvars-description: true\n"), 0644))
+
+	defer delete(changedfs, "description")
+
+	// enabled in config file
+	config := DefaultConfig()
+	c := cfgreader{file: file, config: config}
+	assert.Nil(c.parse())
+	assert.True(config.Settings.TfvarsDescription)
+
+	// disabled by '--description' flag of 'tfvars hcl'
+	changedfs["description"] = true
+	config = DefaultConfig()
+	c = cfgreader{file: file, config: config}
+	assert.Nil(c.parse())
+	assert.False(config.Settings.TfvarsDescription)
+}
+
 func TestOverrideShow(t *testing.T) {
 	tests := []struct {
 		name         string
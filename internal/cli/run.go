@@ -11,17 +11,25 @@ the root directory of this source tree.
 package cli
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	gosort "sort"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
 
 	pluginsdk "github.com/terraform-docs/plugin-sdk/plugin"
 	"github.com/terraform-docs/terraform-docs/internal/format"
+	"github.com/terraform-docs/terraform-docs/internal/lint"
 	"github.com/terraform-docs/terraform-docs/internal/plugin"
+	"github.com/terraform-docs/terraform-docs/internal/site"
+	"github.com/terraform-docs/terraform-docs/internal/template"
 	"github.com/terraform-docs/terraform-docs/internal/terraform"
+	"github.com/terraform-docs/terraform-docs/internal/tfvars"
 )
 
 // list of flagset items which are explicitly changed from CLI
@@ -103,17 +111,44 @@ func RunEFunc(config *Config) func(*cobra.Command, []string) error {
 			return err
 		}
 
+		if config.TemplateDir != "" {
+			templates, err := template.LoadDir(filepath.Join(options.Path, config.TemplateDir))
+			if err != nil {
+				return err
+			}
+			settings.Templates = templates
+		}
+
 		printer, err := format.Factory(config.Formatter, settings)
+		if err != nil && !errors.Is(err, format.ErrNotFound) {
+			return err
+		}
 		if err != nil {
-			plugins, perr := plugin.Discover()
+			plugins, perr := DiscoverPlugins(config)
 			if perr != nil {
 				return fmt.Errorf("formatter '%s' not found", config.Formatter)
 			}
 
-			client, found := plugins.Get(config.Formatter)
-			if !found {
+			defer plugins.Clean()
+
+			env, perr := pluginEnv(config, options.Path)
+			if perr != nil {
+				return perr
+			}
+			for k, v := range env {
+				plugins.Setenv(k, v)
+			}
+
+			client, cerr := plugins.Get(config.Formatter)
+			if errors.Is(cerr, plugin.ErrNotFound) {
+				if _, ok := config.Plugins[config.Formatter]; ok {
+					return fmt.Errorf("plugin '%s' is not installed, run 'terraform-docs plugin install' to install it", config.Formatter)
+				}
 				return fmt.Errorf("formatter '%s' not found", config.Formatter)
 			}
+			if cerr != nil {
+				return fmt.Errorf("plugin '%s' failed to start: %s", config.Formatter, cerr)
+			}
 
 			output, cerr := client.Execute(pluginsdk.ExecuteArgs{
 				Module:   module.Convert(),
@@ -127,6 +162,280 @@ func RunEFunc(config *Config) func(*cobra.Command, []string) error {
 	}
 }
 
+// ValidatePreRunEFunc returns actual 'cobra.Command#PreRunE' function for 'tfvars
+// validate' command. It's the same as PreRunEFunc for the module located at second
+// argument, or the current directory if it's not provided.
+func ValidatePreRunEFunc(config *Config) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path, _ := validateArgs(args)
+		return PreRunEFunc(config)(cmd, []string{path})
+	}
+}
+
+// ValidateRunEFunc returns actual 'cobra.Command#RunE' function for 'tfvars validate'
+// command. This function loads the module located at second argument, or the current
+// directory if it's not provided, and validates tfvars file, provided as first argument,
+// against inputs of the module. All the problems found are printed and an error is
+// returned if there's any.
+func ValidateRunEFunc(config *Config) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path, file := validateArgs(args)
+
+		_, options := config.extract()
+		options.Path = path
+
+		module, err := terraform.LoadWithOptions(options)
+		if err != nil {
+			return err
+		}
+
+		diagnostics, err := tfvars.Validate(module, file)
+		if err != nil {
+			return err
+		}
+
+		for _, d := range diagnostics {
+			fmt.Println(d.String())
+		}
+
+		if len(diagnostics) > 0 {
+			return fmt.Errorf("found %d problem(s) in '%s'", len(diagnostics), file)
+		}
+		return nil
+	}
+}
+
+// validateArgs returns path of the module and tfvars file of 'tfvars validate'
+// command out of its 'args', i.e. 'FILE [PATH]'.
+func validateArgs(args []string) (string, string) {
+	if len(args) > 1 {
+		return args[1], args[0]
+	}
+	return ".", args[0]
+}
+
+// ReadConfig reads the config file from the current directory, if it exists,
+// for commands which don't take path of a module (e.g. 'plugin list').
+func ReadConfig(config *Config) error {
+	cfgreader := &cfgreader{
+		file:   config.File,
+		config: config,
+	}
+	if found, _ := cfgreader.exist(); !found {
+		return nil
+	}
+	return cfgreader.parse()
+}
+
+// DiscoverPlugins discovers the plugins and pins them to their checksums
+// declared in 'plugins' of the config, so none of the pinned plugins is started
+// if its checksum doesn't match.
+func DiscoverPlugins(config *Config) (*plugin.List, error) {
+	plugins, err := plugin.Discover()
+	if err != nil {
+		return nil, err
+	}
+	for name, p := range config.Plugins {
+		plugins.Pin(name, p.SHA256)
+	}
+	return plugins, nil
+}
+
+// LintRunEFunc returns actual 'cobra.Command#RunE' function for 'lint' command.
+// This function loads the module located at first argument, with references of
+// its inputs, and checks it for problems (e.g. unused variables). All the problems
+// found are printed and an error is returned if there's any.
+func LintRunEFunc(config *Config) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		_, options := config.extract()
+		options.Path = args[0]
+		options.InputReferences = true
+
+		module, err := terraform.LoadWithOptions(options)
+		if err != nil {
+			return err
+		}
+
+		findings := lint.Lint(module)
+		for _, f := range findings {
+			fmt.Println(f.String())
+		}
+
+		if len(findings) > 0 {
+			return fmt.Errorf("found %d problem(s) in '%s'", len(findings), args[0])
+		}
+		return nil
+	}
+}
+
+// CSVOptions holds the options of 'csv' and 'tsv' commands.
+type CSVOptions struct {
+	Recursive bool // include all the modules found in the directory
+}
+
+// CSVRunEFunc returns actual 'cobra.Command#RunE' function for 'csv' and 'tsv'
+// commands. If recursive is set, all the modules found in the directory located
+// at first argument are printed, with their path relative to it as the first
+// column of their rows, otherwise it behaves the same as any other formatter.
+func CSVRunEFunc(config *Config, options *CSVOptions) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if !options.Recursive {
+			return RunEFunc(config)(cmd, args)
+		}
+
+		settings, tfoptions := config.extract()
+
+		modules, err := site.LoadModules(args[0], tfoptions)
+		if err != nil {
+			return err
+		}
+
+		printer, err := format.Factory(config.Formatter, settings)
+		if err != nil {
+			return err
+		}
+		csv, ok := printer.(*format.CSV)
+		if !ok {
+			return fmt.Errorf("formatter '%s' doesn't support multiple modules", config.Formatter)
+		}
+
+		output, err := csv.PrintModules(modules, settings)
+		return printOrDie(output, err)
+	}
+}
+
+// SiteOptions holds the options of 'site' command.
+type SiteOptions struct {
+	Format string // format of the pages of the site
+}
+
+// SiteRunEFunc returns actual 'cobra.Command#RunE' function for 'site' command.
+// All the modules found in the catalog located at first argument are rendered
+// along with an index page of the catalog into the directory provided as second
+// argument.
+func SiteRunEFunc(config *Config, options *SiteOptions) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		settings, tfoptions := config.extract()
+
+		if config.TemplateDir != "" {
+			templates, err := template.LoadDir(filepath.Join(args[0], config.TemplateDir))
+			if err != nil {
+				return err
+			}
+			settings.Templates = templates
+		}
+
+		files, err := site.Generate(args[0], args[1], options.Format, tfoptions, settings)
+		if err != nil {
+			return err
+		}
+		for _, f := range files {
+			fmt.Printf("generated '%s'\n", f)
+		}
+		return nil
+	}
+}
+
+// InstallOptions holds the options of 'plugin install' command.
+type InstallOptions struct {
+	Dir    string // directory to install plugins into
+	SHA256 string // expected checksum of the plugin binary
+}
+
+// InstallRunEFunc returns actual 'cobra.Command#RunE' function for 'plugin install'
+// command. The plugin is installed from the source provided as first argument, or
+// if no argument is provided all the plugins declared in 'plugins' of config file
+// are installed from their sources and verified against their checksums.
+func InstallRunEFunc(config *Config, options *InstallOptions) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) == 1 {
+			return install(args[0], options.Dir, options.SHA256)
+		}
+
+		cfgreader := &cfgreader{
+			file:   config.File,
+			config: config,
+		}
+		if found, err := cfgreader.exist(); !found {
+			if err == nil {
+				err = fmt.Errorf("config file '%s' not found", config.File)
+			}
+			return err
+		}
+		if err := cfgreader.parse(); err != nil {
+			return err
+		}
+		if len(config.Plugins) == 0 {
+			return fmt.Errorf("no plugins are declared in '%s'", config.File)
+		}
+
+		names := make([]string, 0, len(config.Plugins))
+		for name := range config.Plugins {
+			names = append(names, name)
+		}
+		gosort.Strings(names)
+
+		for _, name := range names {
+			p := config.Plugins[name]
+			if err := p.validate(name); err != nil {
+				return err
+			}
+			if p.Source == "" {
+				return fmt.Errorf("value of 'plugins.%s.source' can't be empty", name)
+			}
+			if err := install(p.Source, options.Dir, p.SHA256); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func install(source string, dir string, checksum string) error {
+	p, sum, err := plugin.Install(source, dir, checksum)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("installed plugin '%s' to '%s' (sha256: %s)\n", p.Name, p.Path, sum)
+	return nil
+}
+
+// pluginEnv returns the environment variables of the plugin, which makes the
+// module path, the resolved config and 'plugin-options' available to it. The
+// config and the options are encoded in yaml, same as the config file.
+func pluginEnv(config *Config, path string) (map[string]string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := marshal(config.PluginOptions)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		"TFDOCS_MODULE_PATH":    abs,
+		"TFDOCS_CONFIG":         cfg,
+		"TFDOCS_PLUGIN_OPTIONS": opts,
+	}, nil
+}
+
+func marshal(v interface{}) (string, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 func printOrDie(output string, err error) error {
 	if err != nil {
 		return err
@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package cli
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestPluginEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		options map[string]interface{}
+		opts    string
+	}{
+		{
+			name:    "plugin env without options",
+			options: map[string]interface{}{},
+			opts:    "{}\n",
+		},
+		{
+			name: "plugin env with options",
+			options: map[string]interface{}{
+				"foo":  "bar",
+				"list": []int{1, 2},
+			},
+			opts: "foo: bar\nlist:\n  - 1\n  - 2\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := DefaultConfig()
+			config.Formatter = "foo"
+			config.PluginOptions = tt.options
+
+			env, err := pluginEnv(config, "testdata")
+			assert.Nil(err)
+
+			path, _ := filepath.Abs("testdata")
+			assert.Equal(path, env["TFDOCS_MODULE_PATH"])
+			assert.Equal(tt.opts, env["TFDOCS_PLUGIN_OPTIONS"])
+
+			actual := DefaultConfig()
+			assert.Nil(yaml.Unmarshal([]byte(env["TFDOCS_CONFIG"]), actual))
+			assert.Equal("foo", actual.Formatter)
+			assert.Equal(len(tt.options), len(actual.PluginOptions))
+		})
+	}
+}
+
+func TestValidateArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		path string
+		file string
+	}{
+		{
+			name: "validate args with file only",
+			args: []string{"terraform.tfvars"},
+			path: ".",
+			file: "terraform.tfvars",
+		},
+		{
+			name: "validate args with file and path",
+			args: []string{"terraform.tfvars", "modules/foo"},
+			path: "modules/foo",
+			file: "terraform.tfvars",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			path, file := validateArgs(tt.args)
+			assert.Equal(tt.path, path)
+			assert.Equal(tt.file, file)
+		})
+	}
+}
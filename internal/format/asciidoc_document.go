@@ -27,6 +27,57 @@ const (
 		{{- end -}}
 	{{ end -}}
 	`
+	asciidocDocumentUsageTpl = `
+	{{- with .Module.Usage -}}
+		{{ indent 0 "=" }} Usage
+		{{ usage . }}
+	{{ end -}}
+	`
+	asciidocDocumentTOCTpl = `
+	{{- if showTOC -}}
+		{{ indent 0 "=" }} Contents
+		{{ if .Module.Usage }}
+			* <<_usage,Usage>>
+		{{- end }}
+		{{- if .Settings.ShowRequirements }}
+			* <<_requirements,Requirements>>
+		{{- end }}
+		{{- if .Settings.ShowProviders }}
+			* <<_providers,Providers>>
+		{{- end }}
+		{{- if .Settings.ShowModuleCalls }}
+			* <<_modules,Modules>>
+		{{- end }}
+		{{- if .Settings.ShowResources }}
+			* <<_resources,Resources>>
+		{{- end }}
+		{{- if .Settings.ShowInputs }}
+			{{- if .Settings.ShowRequired }}
+				* <<_required_inputs,Required Inputs>>
+				{{- range .Module.RequiredInputs }}
+					** <<input_{{ .Name }},{{ name .Name }}>>
+				{{- end }}
+				* <<_optional_inputs,Optional Inputs>>
+				{{- range .Module.OptionalInputs }}
+					** <<input_{{ .Name }},{{ name .Name }}>>
+				{{- end }}
+			{{- else }}
+				* <<_inputs,Inputs>>
+				{{- range .Module.Inputs }}
+					** <<input_{{ .Name }},{{ name .Name }}>>
+				{{- end }}
+			{{- end }}
+		{{- end }}
+		{{- if .Settings.ShowOutputs }}
+			* <<_outputs,Outputs>>
+			{{- range .Module.Outputs }}
+				** <<output_{{ .Name }},{{ name .Name }}>>
+			{{- end }}
+		{{- end }}
+	{{ printf "\n" }}
+	{{ end -}}
+	`
+
 	asciidocDocumentResourcesTpl = `
 	{{- if .Settings.ShowResources -}}
 		{{ indent 0 "=" }} Resources
@@ -112,14 +163,14 @@ const (
 
 	asciidocDocumentInputTpl = `
 	{{ printf "\n" }}
-	{{ indent 1 "=" }} {{ name .Name }}
+	{{ if showAnchor }}[[input_{{ .Name }}]]{{ printf "\n" }}{{ end }}{{ indent 1 "=" }} {{ name .Name }}
 
 	Description: {{ tostring .Description | sanitizeDoc }}
 
 	Type: {{ tostring .Type | type }}
 
 	{{ if or .HasDefault (not isRequired) }}
-		Default: {{ default "n/a" .GetValue | value }}
+		Default: {{ default "n/a" (valueOf .) | value }}
 	{{- end }}
 	`
 
@@ -132,12 +183,12 @@ const (
 			The following outputs are exported:
 			{{- range .Module.Outputs }}
 
-				{{ indent 1 "=" }} {{ name .Name }}
+				{{ if showAnchor }}[[output_{{ .Name }}]]{{ printf "\n" }}{{ end }}{{ indent 1 "=" }} {{ name .Name }}
 
 				Description: {{ tostring .Description | sanitizeDoc }}
 
 				{{ if $.Settings.OutputValues }}
-					{{- $sensitive := ternary .Sensitive "<sensitive>" .GetValue -}}
+					{{- $sensitive := ternary .Sensitive "<sensitive>" (valueOf .) -}}
 					Value: {{ value $sensitive | sanitizeDoc }}
 
 					{{ if $.Settings.ShowSensitivity -}}
@@ -170,6 +221,8 @@ const (
 
 	asciidocDocumentTpl = `
 	{{- template "header" . -}}
+	{{- template "toc" . -}}
+	{{- template "usage" . -}}
 	{{- template "requirements" . -}}
 	{{- template "providers" . -}}
 	{{- template "modulecalls" . -}}
@@ -185,14 +238,51 @@ type AsciidocDocument struct {
 }
 
 // NewAsciidocDocument returns new instance of AsciidocDocument.
-func NewAsciidocDocument(settings *print.Settings) print.Engine {
+func NewAsciidocDocument(settings *print.Settings) (print.Engine, error) {
 	settings.EscapeCharacters = false
-	tt := template.New(settings, &template.Item{
+	tt, err := template.New(settings, gotemplate.FuncMap{
+		"type": func(t string) string {
+			result, extraline := printFencedAsciidocCodeBlock(t, "hcl")
+			if !extraline {
+				result += "\n"
+			}
+			return result
+		},
+		"value": func(v string) string {
+			if v == "n/a" {
+				return v
+			}
+			result, extraline := printFencedAsciidocCodeBlock(v, valueLanguage(settings))
+			if !extraline {
+				result += "\n"
+			}
+			return result
+		},
+		"isRequired": func() bool {
+			return settings.ShowRequired
+		},
+		"showAnchor": func() bool {
+			return settings.ShowAnchor || settings.ShowTOC
+		},
+		"showTOC": func() bool {
+			return settings.ShowTOC
+		},
+		"usage": func(u string) string {
+			usage, _ := printFencedAsciidocCodeBlock(u, "hcl")
+			return usage
+		},
+	}, &template.Item{
 		Name: "document",
 		Text: asciidocDocumentTpl,
 	}, &template.Item{
 		Name: "header",
 		Text: asciidocDocumentHeaderTpl,
+	}, &template.Item{
+		Name: "usage",
+		Text: asciidocDocumentUsageTpl,
+	}, &template.Item{
+		Name: "toc",
+		Text: asciidocDocumentTOCTpl,
 	}, &template.Item{
 		Name: "requirements",
 		Text: asciidocDocumentRequirementsTpl,
@@ -215,31 +305,12 @@ func NewAsciidocDocument(settings *print.Settings) print.Engine {
 		Name: "modulecalls",
 		Text: asciidocDocumentModulecallsTpl,
 	})
-	tt.CustomFunc(gotemplate.FuncMap{
-		"type": func(t string) string {
-			result, extraline := printFencedAsciidocCodeBlock(t, "hcl")
-			if !extraline {
-				result += "\n"
-			}
-			return result
-		},
-		"value": func(v string) string {
-			if v == "n/a" {
-				return v
-			}
-			result, extraline := printFencedAsciidocCodeBlock(v, "json")
-			if !extraline {
-				result += "\n"
-			}
-			return result
-		},
-		"isRequired": func() bool {
-			return settings.ShowRequired
-		},
-	})
+	if err != nil {
+		return nil, err
+	}
 	return &AsciidocDocument{
 		template: tt,
-	}
+	}, nil
 }
 
 // Print a Terraform module as AsciiDoc document.
@@ -31,7 +31,8 @@ func TestAsciidocDocument(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -51,7 +52,8 @@ func TestAsciidocDocumentWithRequired(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -77,7 +79,8 @@ func TestAsciidocDocumentSortByName(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -105,7 +108,8 @@ func TestAsciidocDocumentSortByRequired(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -131,7 +135,8 @@ func TestAsciidocDocumentSortByType(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -157,7 +162,8 @@ func TestAsciidocDocumentNoHeader(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -183,7 +189,8 @@ func TestAsciidocDocumentNoInputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -209,7 +216,8 @@ func TestAsciidocDocumentNoModulecalls(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -235,7 +243,8 @@ func TestAsciidocDocumentNoOutputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -261,7 +270,8 @@ func TestAsciidocDocumentNoProviders(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -287,7 +297,8 @@ func TestAsciidocDocumentNoRequirements(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -313,7 +324,8 @@ func TestAsciidocDocumentNoResources(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -339,7 +351,8 @@ func TestAsciidocDocumentOnlyHeader(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -365,7 +378,8 @@ func TestAsciidocDocumentOnlyInputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -391,7 +405,8 @@ func TestAsciidocDocumentOnlyModulecalls(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -417,7 +432,8 @@ func TestAsciidocDocumentOnlyOutputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -443,7 +459,8 @@ func TestAsciidocDocumentOnlyProviders(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -469,7 +486,8 @@ func TestAsciidocDocumentOnlyRequirements(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -495,7 +513,8 @@ func TestAsciidocDocumentOnlyResources(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -515,7 +534,8 @@ func TestAsciidocDocumentIndentationBelowAllowed(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -535,7 +555,8 @@ func TestAsciidocDocumentIndentationAboveAllowed(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -555,7 +576,8 @@ func TestAsciidocDocumentIndentationOfFour(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -581,7 +603,109 @@ func TestAsciidocDocumentOutputValues(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentValueFormatHCL(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		ShowSensitivity: true,
+		ValueFormat:     "hcl",
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-ValueFormatHCL")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		OutputValues:     true,
+		OutputValuesPath: "output_values.json",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentTOC(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		EscapeCharacters: true,
+		ShowRequired:     true,
+		ShowTOC:          true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-TOC")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentTOCWithoutRequired(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowHeader: true,
+		ShowInputs: true,
+		ShowTOC:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-TOCWithoutRequired")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentAnchor(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowAnchor: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-Anchor")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -631,7 +755,8 @@ func TestAsciidocDocumentHeaderFromFile(t *testing.T) {
 			module, err := testutil.GetModule(options)
 			assert.Nil(err)
 
-			printer := NewAsciidocDocument(settings)
+			printer, err := NewAsciidocDocument(settings)
+			assert.Nil(err)
 			actual, err := printer.Print(module, settings)
 
 			assert.Nil(err)
@@ -659,7 +784,36 @@ func TestAsciidocDocumentOutputValuesNoSensitivity(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentUsage(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-Usage")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		ShowUsage: true,
+		Usage: &terraform.Usage{
+			Source:   "terraform-docs/example/aws",
+			Version:  "1.0.0",
+			Optional: true,
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -687,7 +841,8 @@ func TestAsciidocDocumentEmpty(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocDocument(settings)
+	printer, err := NewAsciidocDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -27,6 +27,12 @@ const (
 		{{- end -}}
 	{{ end -}}
 	`
+	asciidocTableUsageTpl = `
+	{{- with .Module.Usage -}}
+		{{ indent 0 "=" }} Usage
+		{{ usage . }}
+	{{ end -}}
+	`
 	asciidocTableResourcesTpl = `
 	{{- if .Settings.ShowResources -}}
 		{{ indent 0 "=" }} Resources
@@ -95,7 +101,7 @@ const (
 				|{{ .Name }}
 				|{{ tostring .Description | sanitizeAsciidocTbl }}
 				|{{ tostring .Type | type | sanitizeAsciidocTbl }}
-				|{{ value .GetValue | sanitizeAsciidocTbl }}
+				|{{ value (valueOf .) | sanitizeAsciidocTbl }}
 				{{ if $.Settings.ShowRequired }}|{{ ternary .Required "yes" "no" }}{{ end }}
 			{{ end }}
 			|===
@@ -115,7 +121,7 @@ const (
 			{{- range .Module.Outputs }}
 				|{{ .Name }} |{{ tostring .Description | sanitizeAsciidocTbl }}
 				{{- if $.Settings.OutputValues -}}
-					{{- $sensitive := ternary .Sensitive "<sensitive>" .GetValue -}}
+					{{- $sensitive := ternary .Sensitive "<sensitive>" (valueOf .) -}}
 					{{ printf " " }}|{{ value $sensitive }}
 					{{- if $.Settings.ShowSensitivity -}}
 						{{ printf " " }}|{{ ternary .Sensitive "yes" "no" }}
@@ -146,6 +152,7 @@ const (
 
 	asciidocTableTpl = `
 	{{- template "header" . -}}
+	{{- template "usage" . -}}
 	{{- template "requirements" . -}}
 	{{- template "providers" . -}}
 	{{- template "modulecalls" . -}}
@@ -161,14 +168,33 @@ type AsciidocTable struct {
 }
 
 // NewAsciidocTable returns new instance of AsciidocTable.
-func NewAsciidocTable(settings *print.Settings) print.Engine {
+func NewAsciidocTable(settings *print.Settings) (print.Engine, error) {
 	settings.EscapeCharacters = false
-	tt := template.New(settings, &template.Item{
+	tt, err := template.New(settings, gotemplate.FuncMap{
+		"type": func(t string) string {
+			inputType, _ := printFencedCodeBlock(t, "")
+			return inputType
+		},
+		"value": func(v string) string {
+			var result = "n/a"
+			if v != "" {
+				result, _ = printFencedCodeBlock(v, "")
+			}
+			return result
+		},
+		"usage": func(u string) string {
+			usage, _ := printFencedAsciidocCodeBlock(u, "hcl")
+			return usage
+		},
+	}, &template.Item{
 		Name: "table",
 		Text: asciidocTableTpl,
 	}, &template.Item{
 		Name: "header",
 		Text: asciidocTableHeaderTpl,
+	}, &template.Item{
+		Name: "usage",
+		Text: asciidocTableUsageTpl,
 	}, &template.Item{
 		Name: "resources",
 		Text: asciidocTableResourcesTpl,
@@ -188,22 +214,12 @@ func NewAsciidocTable(settings *print.Settings) print.Engine {
 		Name: "modulecalls",
 		Text: asciidocTableModulecallsTpl,
 	})
-	tt.CustomFunc(gotemplate.FuncMap{
-		"type": func(t string) string {
-			inputType, _ := printFencedCodeBlock(t, "")
-			return inputType
-		},
-		"value": func(v string) string {
-			var result = "n/a"
-			if v != "" {
-				result, _ = printFencedCodeBlock(v, "")
-			}
-			return result
-		},
-	})
+	if err != nil {
+		return nil, err
+	}
 	return &AsciidocTable{
 		template: tt,
-	}
+	}, nil
 }
 
 // Print a Terraform module as AsciiDoc tables.
@@ -31,7 +31,8 @@ func TestAsciidocTable(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -51,7 +52,8 @@ func TestAsciidocTableWithRequired(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -77,7 +79,8 @@ func TestAsciidocTableSortByName(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -105,7 +108,8 @@ func TestAsciidocTableSortByRequired(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -131,7 +135,8 @@ func TestAsciidocTableSortByType(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -157,7 +162,8 @@ func TestAsciidocTableNoHeader(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -183,7 +189,8 @@ func TestAsciidocTableNoInputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -209,7 +216,8 @@ func TestAsciidocTableNoModulecalls(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -234,7 +242,8 @@ func TestAsciidocTableNoOutputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -260,7 +269,8 @@ func TestAsciidocTableNoProviders(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -286,7 +296,8 @@ func TestAsciidocTableNoRequirements(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -312,7 +323,8 @@ func TestAsciidocTableNoResources(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -338,7 +350,8 @@ func TestAsciidocTableOnlyHeader(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -364,7 +377,8 @@ func TestAsciidocTableOnlyInputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -390,7 +404,8 @@ func TestAsciidocTableOnlyModulecalls(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -416,7 +431,8 @@ func TestAsciidocTableOnlyOutputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -442,7 +458,8 @@ func TestAsciidocTableOnlyProviders(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -468,7 +485,8 @@ func TestAsciidocTableOnlyRequirements(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -494,7 +512,8 @@ func TestAsciidocTableOnlyResources(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -514,7 +533,8 @@ func TestAsciidocTableIndentationBelowAllowed(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -534,7 +554,8 @@ func TestAsciidocTableIndentationAboveAllowed(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -554,7 +575,8 @@ func TestAsciidocTableIndentationOfFour(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -580,7 +602,36 @@ func TestAsciidocTableOutputValues(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableValueFormatHCL(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		ShowSensitivity: true,
+		ValueFormat:     "hcl",
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-ValueFormatHCL")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		OutputValues:     true,
+		OutputValuesPath: "output_values.json",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -630,7 +681,8 @@ func TestAsciidocTableHeaderFromFile(t *testing.T) {
 			module, err := testutil.GetModule(options)
 			assert.Nil(err)
 
-			printer := NewAsciidocTable(settings)
+			printer, err := NewAsciidocTable(settings)
+			assert.Nil(err)
 			actual, err := printer.Print(module, settings)
 
 			assert.Nil(err)
@@ -658,7 +710,36 @@ func TestAsciidocTableOutputValuesNoSensitivity(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableUsage(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-Usage")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		ShowUsage: true,
+		Usage: &terraform.Usage{
+			Source:   "terraform-docs/example/aws",
+			Version:  "1.0.0",
+			Optional: true,
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -686,7 +767,8 @@ func TestAsciidocTableEmpty(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewAsciidocTable(settings)
+	printer, err := NewAsciidocTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -0,0 +1,279 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+)
+
+const (
+	confluenceTpl = `
+{{- if and .Settings.ShowHeader .Module.Header }}
+{{ paragraphs .Module.Header }}
+{{- end }}
+{{- with .Module.Usage }}
+<h2>Usage</h2>
+{{ code "hcl" . }}
+{{- end }}
+{{- if .Settings.ShowRequirements }}
+<h2>Requirements</h2>
+{{- if not .Module.Requirements }}
+<p>No requirements.</p>
+{{- else }}
+<table>
+<tbody>
+<tr><th>Name</th><th>Version</th></tr>
+{{- range .Module.Requirements }}
+<tr><td>{{ .Name }}</td><td>{{ ternary (eq (tostring .Version) "") "n/a" (tostring .Version) }}</td></tr>
+{{- end }}
+</tbody>
+</table>
+{{- end }}
+{{- end }}
+{{- if .Settings.ShowProviders }}
+<h2>Providers</h2>
+{{- if not .Module.Providers }}
+<p>No providers.</p>
+{{- else }}
+<table>
+<tbody>
+<tr><th>Name</th><th>Version</th></tr>
+{{- range .Module.Providers }}
+<tr><td>{{ .FullName }}</td><td>{{ ternary (eq (tostring .Version) "") "n/a" (tostring .Version) }}</td></tr>
+{{- end }}
+</tbody>
+</table>
+{{- end }}
+{{- end }}
+{{- if .Settings.ShowModuleCalls }}
+<h2>Modules</h2>
+{{- if not .Module.ModuleCalls }}
+<p>No modules.</p>
+{{- else }}
+<table>
+<tbody>
+<tr><th>Name</th><th>Source</th><th>Version</th></tr>
+{{- range .Module.ModuleCalls }}
+<tr><td>{{ .Name }}</td><td><code>{{ .Source }}</code></td><td>{{ ternary (eq .Version "") "n/a" .Version }}</td></tr>
+{{- end }}
+</tbody>
+</table>
+{{- end }}
+{{- end }}
+{{- if .Settings.ShowResources }}
+<h2>Resources</h2>
+{{- if not .Module.Resources }}
+<p>No resources.</p>
+{{- else }}
+<table>
+<tbody>
+<tr><th>Name</th><th>Type</th></tr>
+{{- range .Module.Resources }}
+<tr><td>{{ if .URL }}<a href="{{ .URL }}">{{ .FullType }}</a>{{ else }}{{ .FullType }}{{ end }}</td><td>{{ if eq .Mode "data" }}data source{{ else }}resource{{ end }}</td></tr>
+{{- end }}
+</tbody>
+</table>
+{{- end }}
+{{- end }}
+{{- if .Settings.ShowInputs }}
+<h2>Inputs</h2>
+{{- if not .Module.Inputs }}
+<p>No inputs.</p>
+{{- else }}
+<table>
+<tbody>
+<tr><th>Name</th><th>Description</th><th>Type</th><th>Default</th>{{ if .Settings.ShowRequired }}<th>Required</th>{{ end }}</tr>
+{{- range .Module.Inputs }}
+<tr><td>{{ .Name }}</td><td>{{ if isDeprecated (tostring .Description) }}{{ panel "note" "Deprecated" (tostring .Description) }}{{ else }}{{ text (tostring .Description) }}{{ end }}</td><td>{{ code "hcl" (tostring .Type) }}</td><td>{{ if .HasDefault }}{{ code valueFormat (valueOf .) }}{{ else }}n/a{{ end }}</td>{{ if $.Settings.ShowRequired }}<td>{{ ternary .Required "yes" "no" }}</td>{{ end }}</tr>
+{{- end }}
+</tbody>
+</table>
+{{- end }}
+{{- end }}
+{{- if .Settings.ShowOutputs }}
+<h2>Outputs</h2>
+{{- if not .Module.Outputs }}
+<p>No outputs.</p>
+{{- else }}
+<table>
+<tbody>
+<tr><th>Name</th><th>Description</th>{{ if .Settings.OutputValues }}<th>Value</th>{{ if .Settings.ShowSensitivity }}<th>Sensitive</th>{{ end }}{{ end }}</tr>
+{{- range .Module.Outputs }}
+<tr><td>{{ .Name }}</td><td>{{ if isDeprecated (tostring .Description) }}{{ panel "note" "Deprecated" (tostring .Description) }}{{ else }}{{ text (tostring .Description) }}{{ end }}{{ if and .Sensitive $.Settings.ShowSensitivity }}{{ panel "info" "Sensitive" "The value of this output is sensitive." }}{{ end }}</td>{{ if $.Settings.OutputValues }}<td>{{ if .Sensitive }}<code>&lt;sensitive&gt;</code>{{ else }}{{ code valueFormat (valueOf .) }}{{ end }}</td>{{ if $.Settings.ShowSensitivity }}<td>{{ ternary .Sensitive "yes" "no" }}</td>{{ end }}{{ end }}</tr>
+{{- end }}
+</tbody>
+</table>
+{{- end }}
+{{- end }}
+`
+)
+
+// Confluence represents Confluence storage format, the XHTML-based format
+// which Confluence stores pages in and accepts from its REST API.
+type Confluence struct {
+	template *htmltemplate.Template
+}
+
+// NewConfluence returns new instance of Confluence.
+func NewConfluence(settings *print.Settings) (print.Engine, error) {
+	tt := htmltemplate.Must(htmltemplate.New("confluence").Funcs(htmlFuncs(settings, htmltemplate.FuncMap{
+		"valueFormat": func() string {
+			return valueLanguage(settings)
+		},
+		"isDeprecated": isDeprecated,
+		"code":         confluenceCode,
+		"text":         confluenceText,
+		"paragraphs":   confluenceParagraphs,
+		"panel":        confluencePanel,
+	})).Parse(confluenceTpl))
+	return &Confluence{
+		template: tt,
+	}, nil
+}
+
+// Print a Terraform module as Confluence storage format. All the content of
+// the module is escaped, and header and descriptions are rendered as plain text.
+func (c *Confluence) Print(module *terraform.Module, settings *print.Settings) (string, error) {
+	buffer := new(bytes.Buffer)
+	err := c.template.Execute(buffer, struct {
+		Module   *terraform.Module
+		Settings *print.Settings
+	}{
+		Module:   module,
+		Settings: settings,
+	})
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buffer.String()), nil
+}
+
+// isDeprecated returns true if 'description' marks the item as deprecated,
+// i.e. it starts with 'Deprecated' (case insensitive). Terraform doesn't have
+// a native way to deprecate variables and outputs, so this is the convention.
+func isDeprecated(description string) bool {
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(description)), "deprecated")
+}
+
+// confluenceLanguages maps languages of code blocks to the ones supported by
+// Confluence code macro, which doesn't know HCL and, on older versions, JSON.
+// Code blocks in any other languages are rendered without highlighting.
+var confluenceLanguages = map[string]string{
+	"bash":  "bash",
+	"hcl":   "none",
+	"json":  "js",
+	"sh":    "bash",
+	"shell": "bash",
+	"yaml":  "yml",
+	"yml":   "yml",
+}
+
+// confluenceCode returns 'code' as an inline code, or as a code macro if it's
+// multi-line (e.g. nested object types or values).
+func confluenceCode(language string, code string) htmltemplate.HTML {
+	if code == "" {
+		return "n/a"
+	}
+	if !strings.Contains(code, "\n") {
+		return htmltemplate.HTML("<code>" + htmltemplate.HTMLEscapeString(code) + "</code>") //nolint:gosec
+	}
+	return confluenceCodeMacro(language, code)
+}
+
+// confluenceCodeMacro returns 'code' as a code macro in 'language'.
+func confluenceCodeMacro(language string, code string) htmltemplate.HTML {
+	lang, ok := confluenceLanguages[language]
+	if !ok {
+		lang = "none"
+	}
+	// body of code macro is CDATA, which can't contain its own terminator
+	body := strings.ReplaceAll(code, "]]>", "]]]]><![CDATA[>")
+	return htmltemplate.HTML(`<ac:structured-macro ac:name="code">` + //nolint:gosec
+		`<ac:parameter ac:name="language">` + lang + `</ac:parameter>` +
+		`<ac:plain-text-body><![CDATA[` + body + `]]></ac:plain-text-body>` +
+		`</ac:structured-macro>`)
+}
+
+// confluenceText returns escaped 's' with its line breaks preserved, or
+// 'n/a' if it's empty.
+func confluenceText(s string) htmltemplate.HTML {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "n/a"
+	}
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = htmltemplate.HTMLEscapeString(strings.TrimRight(line, " \t\r"))
+	}
+	return htmltemplate.HTML(strings.Join(lines, "<br />")) //nolint:gosec
+}
+
+// confluenceParagraphs returns escaped 's' as paragraphs, which are separated
+// by blank lines. Fenced code blocks (```) are rendered as code macros.
+func confluenceParagraphs(s string) htmltemplate.HTML {
+	blocks := []string{}
+	paragraph := []string{}
+	flush := func() {
+		if text := strings.TrimSpace(strings.Join(paragraph, "\n")); text != "" {
+			blocks = append(blocks, "<p>"+string(confluenceText(text))+"</p>")
+		}
+		paragraph = []string{}
+	}
+
+	var code []string
+	var language string
+	for _, line := range strings.Split(strings.TrimSpace(s), "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case code == nil && strings.HasPrefix(trimmed, "```"):
+			flush()
+			code = []string{}
+			language = strings.TrimPrefix(trimmed, "```")
+		case code != nil && trimmed == "```":
+			blocks = append(blocks, string(confluenceCodeMacro(language, strings.Join(code, "\n"))))
+			code = nil
+		case code != nil:
+			code = append(code, line)
+		case trimmed == "":
+			flush()
+		default:
+			paragraph = append(paragraph, line)
+		}
+	}
+	if code != nil {
+		// unterminated code block is kept as text
+		paragraph = append(paragraph, "```"+language)
+		paragraph = append(paragraph, code...)
+	}
+	flush()
+
+	return htmltemplate.HTML(strings.Join(blocks, "\n")) //nolint:gosec
+}
+
+// confluencePanel returns 'body' in a panel macro 'name' (e.g. 'info' or
+// 'note') with 'title'.
+func confluencePanel(name string, title string, body string) htmltemplate.HTML {
+	return htmltemplate.HTML(`<ac:structured-macro ac:name="` + name + `">` + //nolint:gosec
+		`<ac:parameter ac:name="title">` + htmltemplate.HTMLEscapeString(title) + `</ac:parameter>` +
+		`<ac:rich-text-body><p>` + string(confluenceText(body)) + `</p></ac:rich-text-body>` +
+		`</ac:structured-macro>`)
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"confluence": NewConfluence,
+	})
+}
@@ -0,0 +1,356 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+func TestConfluence(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowRequired: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewConfluence(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceSortByName(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SortByName: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-SortByName")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		SortBy: &terraform.SortBy{
+			Name: true,
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewConfluence(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceNoHeader(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowHeader:       false,
+		ShowInputs:       true,
+		ShowModuleCalls:  true,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-NoHeader")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewConfluence(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceOnlyInputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowHeader:       false,
+		ShowInputs:       true,
+		ShowModuleCalls:  false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+		ValueFormat:      "hcl",
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-OnlyInputs")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewConfluence(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceOutputValues(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		ShowSensitivity: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-OutputValues")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		OutputValues:     true,
+		OutputValuesPath: "output_values.json",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewConfluence(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceUsage(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-Usage")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		ShowUsage: true,
+		Usage: &terraform.Usage{
+			Source:   "terraform-docs/example/aws",
+			Version:  "1.0.0",
+			Optional: true,
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewConfluence(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceEmpty(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModuleCalls:  false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-Empty")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().WithOverwrite(&terraform.Options{
+		HeaderFromFile: "bad.tf",
+	})
+	options.ShowHeader = false // Since we don't show the header, the file won't be loaded at all
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewConfluence(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceEscape(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	module := &terraform.Module{
+		Header: "<h1>header</h1>",
+		Inputs: []*terraform.Input{
+			{
+				Name:        "foo",
+				Type:        types.String("string"),
+				Description: types.String("<script>alert('foo')</script>"),
+				Default:     types.ValueOf("\"><img src=x onerror=alert(1)>"),
+			},
+		},
+		Outputs: []*terraform.Output{
+			{
+				Name:        "bar",
+				Description: types.String("a | b & <c>"),
+			},
+		},
+	}
+
+	printer, err := NewConfluence(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.NotContains(actual, "<h1>")
+	assert.NotContains(actual, "<script>alert")
+	assert.NotContains(actual, "<img")
+	assert.Contains(actual, "<p>&lt;h1&gt;header&lt;/h1&gt;</p>")
+	assert.Contains(actual, "&lt;script&gt;alert(&#39;foo&#39;)&lt;/script&gt;")
+	assert.Contains(actual, "a | b &amp; &lt;c&gt;")
+}
+
+func TestConfluencePanels(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowSensitivity: true,
+	}).Build()
+
+	module := &terraform.Module{
+		Inputs: []*terraform.Input{
+			{
+				Name:        "foo",
+				Type:        types.String("string"),
+				Description: types.String("Deprecated: use 'bar' instead."),
+				Default:     types.ValueOf("foo"),
+			},
+		},
+		Outputs: []*terraform.Output{
+			{
+				Name:        "secret",
+				Description: types.String("a | b & <c>"),
+				Sensitive:   true,
+			},
+		},
+	}
+
+	printer, err := NewConfluence(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Contains(actual, `<ac:structured-macro ac:name="note"><ac:parameter ac:name="title">Deprecated</ac:parameter><ac:rich-text-body><p>Deprecated: use &#39;bar&#39; instead.</p></ac:rich-text-body></ac:structured-macro>`)
+	assert.Contains(actual, `<td>a | b &amp; &lt;c&gt;<ac:structured-macro ac:name="info"><ac:parameter ac:name="title">Sensitive</ac:parameter>`)
+}
+
+func TestConfluenceCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		language string
+		code     string
+		expected string
+	}{
+		{
+			name:     "empty code",
+			language: "json",
+			code:     "",
+			expected: "n/a",
+		},
+		{
+			name:     "single line code",
+			language: "json",
+			code:     "\"<foo>\"",
+			expected: "<code>&#34;&lt;foo&gt;&#34;</code>",
+		},
+		{
+			name:     "multi line code",
+			language: "json",
+			code:     "[\n  \"a\"\n]",
+			expected: "<ac:structured-macro ac:name=\"code\"><ac:parameter ac:name=\"language\">js</ac:parameter><ac:plain-text-body><![CDATA[[\n  \"a\"\n]]]></ac:plain-text-body></ac:structured-macro>",
+		},
+		{
+			name:     "multi line code with cdata terminator",
+			language: "hcl",
+			code:     "<<EOT\n]]>\nEOT",
+			expected: "<ac:structured-macro ac:name=\"code\"><ac:parameter ac:name=\"language\">none</ac:parameter><ac:plain-text-body><![CDATA[<<EOT\n]]]]><![CDATA[>\nEOT]]></ac:plain-text-body></ac:structured-macro>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := confluenceCode(tt.language, tt.code)
+			assert.Equal(tt.expected, string(actual))
+		})
+	}
+}
+
+func TestIsDeprecated(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		expected    bool
+	}{
+		{
+			name:        "empty description",
+			description: "",
+			expected:    false,
+		},
+		{
+			name:        "deprecated prefix",
+			description: "Deprecated: use 'bar' instead.",
+			expected:    true,
+		},
+		{
+			name:        "uppercase deprecated prefix",
+			description: "  DEPRECATED, will be removed in 2.0.",
+			expected:    true,
+		},
+		{
+			name:        "deprecated in the middle",
+			description: "Replaces the deprecated 'foo'.",
+			expected:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, isDeprecated(tt.description))
+		})
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+)
+
+// csvColumns is the list of columns of CSV, except 'module' column which is
+// only added when multiple modules are printed.
+var csvColumns = []string{"kind", "name", "type", "default", "required", "sensitive", "description", "position"}
+
+// CSV represents CSV format, with one row per each input, output, resource and
+// provider of the module. Rows of each kind are only printed if its section is
+// shown (e.g. '--hide resources' removes resource rows). Resources have one row
+// per block with no position, as the position is only kept per type.
+// Cells starting with '=', '+', '-' or '@' are prefixed with a single quote, so
+// spreadsheets don't evaluate them as formulas.
+type CSV struct {
+	comma rune
+}
+
+// NewCSV returns new instance of CSV.
+func NewCSV(settings *print.Settings) (print.Engine, error) {
+	return &CSV{
+		comma: ',',
+	}, nil
+}
+
+// NewTSV returns new instance of CSV which separates fields with tab.
+func NewTSV(settings *print.Settings) (print.Engine, error) {
+	return &CSV{
+		comma: '\t',
+	}, nil
+}
+
+// Print a Terraform module as CSV.
+func (c *CSV) Print(module *terraform.Module, settings *print.Settings) (string, error) {
+	return c.write(csvColumns, func(w *csv.Writer) error {
+		return w.WriteAll(csvRows(module, settings))
+	})
+}
+
+// PrintModules prints multiple Terraform modules as CSV, with path of each
+// module as their key in 'modules' and as the first column of their rows.
+// Modules are printed sorted by their path.
+func (c *CSV) PrintModules(modules map[string]*terraform.Module, settings *print.Settings) (string, error) {
+	paths := make([]string, 0, len(modules))
+	for p := range modules {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	return c.write(append([]string{"module"}, csvColumns...), func(w *csv.Writer) error {
+		for _, p := range paths {
+			for _, row := range csvRows(modules[p], settings) {
+				if err := w.Write(append([]string{csvCell(p)}, row...)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (c *CSV) write(header []string, rows func(w *csv.Writer) error) (string, error) {
+	buffer := new(bytes.Buffer)
+	w := csv.NewWriter(buffer)
+	w.Comma = c.comma
+
+	if err := w.Write(header); err != nil {
+		return "", err
+	}
+	if err := rows(w); err != nil {
+		return "", err
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+func csvRows(module *terraform.Module, settings *print.Settings) [][]string {
+	rows := [][]string{}
+	if settings.ShowInputs {
+		for _, i := range module.Inputs {
+			var value string
+			if i.HasDefault() {
+				value = csvValue(i.Default)
+			}
+			rows = append(rows, []string{"input", i.Name, string(i.Type), value, strconv.FormatBool(i.Required), "", string(i.Description), csvPosition(i.Position)})
+		}
+	}
+	if settings.ShowOutputs {
+		for _, o := range module.Outputs {
+			var value string
+			if settings.OutputValues && !o.Sensitive {
+				value = csvValue(o.Value)
+			}
+			rows = append(rows, []string{"output", o.Name, "", value, "", strconv.FormatBool(o.Sensitive), string(o.Description), csvPosition(o.Position)})
+		}
+	}
+	if settings.ShowResources {
+		for _, r := range module.Resources {
+			kind := "resource"
+			if r.Mode == "data" {
+				kind = "data source"
+			}
+			names := r.Names
+			if len(names) == 0 {
+				names = []string{""}
+			}
+			// resources are unique by type, i.e. they have no single position
+			for _, name := range names {
+				rows = append(rows, []string{kind, name, r.FullType(), "", "", "", "", ""})
+			}
+		}
+	}
+	if settings.ShowProviders {
+		for _, p := range module.Providers {
+			rows = append(rows, []string{"provider", p.FullName(), "", "", "", "", "", csvPosition(p.Position)})
+		}
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			row[i] = csvCell(cell)
+		}
+	}
+	return rows
+}
+
+// csvCell returns 'cell' prefixed with a single quote if it starts with any of
+// the characters which make spreadsheets evaluate it as formula.
+func csvCell(cell string) string {
+	if cell != "" && strings.ContainsAny(cell[:1], "=+-@") {
+		return "'" + cell
+	}
+	return cell
+}
+
+// csvValue returns compact JSON representation of 'v'.
+func csvValue(v interface{}) string {
+	buffer := new(bytes.Buffer)
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+// csvPosition returns 'file:line' of 'p'. Only the name of the file is used,
+// which is enough to locate it in the directory of the module.
+func csvPosition(p terraform.Position) string {
+	if p.Filename == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", filepath.Base(p.Filename), p.Line)
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"csv": NewCSV,
+		"tsv": NewTSV,
+	})
+}
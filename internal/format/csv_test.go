@@ -0,0 +1,213 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+func TestCSV(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	expected, err := testutil.GetExpected("csv", "csv")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewCSV(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestCSVOnlyInputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowHeader:       false,
+		ShowInputs:       true,
+		ShowModuleCalls:  false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("csv", "csv-OnlyInputs")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewCSV(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestCSVOutputValues(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowOutputs:  true,
+		OutputValues: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("csv", "csv-OutputValues")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		OutputValues:     true,
+		OutputValuesPath: "output_values.json",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewCSV(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestCSVEmpty(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModuleCalls:  false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewCSV(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal("kind,name,type,default,required,sensitive,description,position", actual)
+}
+
+func TestTSV(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	expected, err := testutil.GetExpected("csv", "tsv")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewTSV(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestCSVPrintModules(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	module := func(name string, description string) *terraform.Module {
+		return &terraform.Module{
+			Inputs: []*terraform.Input{
+				{
+					Name:        name,
+					Type:        types.String("list(string)"),
+					Description: types.String(description),
+					Default:     types.ValueOf([]interface{}{"a", "<b>"}),
+					Position:    terraform.Position{Filename: "/tmp/" + name + "/variables.tf", Line: 3},
+				},
+			},
+		}
+	}
+	modules := map[string]*terraform.Module{
+		"modules/foo": module("foo", "Foo, \"quoted\"\nand multi-line."),
+		".":           module("root", ""),
+	}
+
+	engine, err := NewCSV(settings)
+	assert.Nil(err)
+	printer := engine.(*CSV)
+	actual, err := printer.PrintModules(modules, settings)
+
+	assert.Nil(err)
+	assert.Equal(`module,kind,name,type,default,required,sensitive,description,position
+.,input,root,list(string),"[""a"",""<b>""]",false,,,variables.tf:3
+modules/foo,input,foo,list(string),"[""a"",""<b>""]",false,,"Foo, ""quoted""
+and multi-line.",variables.tf:3`, actual)
+}
+
+func TestCSVFormula(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	module := &terraform.Module{
+		Inputs: []*terraform.Input{
+			{
+				Name:        "offset",
+				Type:        types.String("number"),
+				Description: types.String("=HYPERLINK(\"https://example.com\")"),
+				Default:     types.ValueOf(-1),
+			},
+			{
+				Name:        "email",
+				Type:        types.String("string"),
+				Description: types.String("@SUM(1+1), or +1"),
+				Default:     types.ValueOf("a=b"),
+			},
+		},
+	}
+	modules := map[string]*terraform.Module{
+		"-modules/foo": module,
+	}
+
+	engine, err := NewCSV(settings)
+	assert.Nil(err)
+	printer := engine.(*CSV)
+
+	actual, err := printer.Print(module, settings)
+	assert.Nil(err)
+	assert.Equal(`kind,name,type,default,required,sensitive,description,position
+input,offset,number,'-1,false,,"'=HYPERLINK(""https://example.com"")",
+input,email,string,"""a=b""",false,,"'@SUM(1+1), or +1",`, actual)
+
+	actual, err = printer.PrintModules(modules, settings)
+	assert.Nil(err)
+	assert.Equal(`module,kind,name,type,default,required,sensitive,description,position
+'-modules/foo,input,offset,number,'-1,false,,"'=HYPERLINK(""https://example.com"")",
+'-modules/foo,input,email,string,"""a=b""",false,,"'@SUM(1+1), or +1",`, actual)
+}
@@ -11,35 +11,93 @@ the root directory of this source tree.
 package format
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"sync"
 
 	"github.com/terraform-docs/terraform-docs/internal/print"
 )
 
-// initializerFn returns a concrete implementation of an Engine.
-type initializerFn func(*print.Settings) print.Engine
+// ErrNotFound is returned by Factory if no formatter is registered with the
+// given name.
+var ErrNotFound = errors.New("formatter not found")
+
+// initializerFn returns a concrete implementation of an Engine, or an error if
+// it can't be initialized with the settings, e.g. templates provided by users
+// can't be parsed.
+type initializerFn func(*print.Settings) (print.Engine, error)
 
 // initializers list of all registered engine initializer functions.
 var initializers = make(map[string]initializerFn)
 
+// lock guards initializers against concurrent registration of custom
+// formatters and lookups.
+var lock sync.RWMutex
+
 // register a formatter engine initializer function.
 func register(e map[string]initializerFn) {
 	if e == nil {
 		return
 	}
+	lock.Lock()
+	defer lock.Unlock()
 	for k, v := range e {
 		initializers[k] = v
 	}
 }
 
+// Register registers a custom formatter engine initializer function 'fn' with
+// 'name', after which the formatter is available through Factory the same way
+// built-in ones are. An error is returned if 'name' is empty, 'fn' is nil or
+// a formatter with the same name is already registered.
+func Register(name string, fn func(*print.Settings) (print.Engine, error)) error {
+	if name == "" {
+		return fmt.Errorf("formatter name can't be empty")
+	}
+	if fn == nil {
+		return fmt.Errorf("formatter '%s' initializer can't be nil", name)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if _, ok := initializers[name]; ok {
+		return fmt.Errorf("formatter '%s' is already registered", name)
+	}
+	initializers[name] = fn
+	return nil
+}
+
+// Unregister removes the formatter registered with 'name', if any, e.g. to
+// clean up custom formatters registered by tests.
+func Unregister(name string) {
+	lock.Lock()
+	defer lock.Unlock()
+	delete(initializers, name)
+}
+
 // Factory initializes and returns the concrete implementation of
 // format.Engine based on the provided 'name', for example for name
 // of 'json' it will return '*format.JSON' through 'format.NewJSON'
-// function.
+// function. An error is returned if the formatter can't be initialized.
 func Factory(name string, settings *print.Settings) (print.Engine, error) {
+	lock.RLock()
 	fn, ok := initializers[name]
+	lock.RUnlock()
 	if !ok {
-		return nil, fmt.Errorf("formatter '%s' not found", name)
+		return nil, fmt.Errorf("%w: '%s'", ErrNotFound, name)
+	}
+	return fn(settings)
+}
+
+// Names returns sorted list of names of all the registered formatters,
+// including their aliases (e.g. 'md' for 'markdown').
+func Names() []string {
+	lock.RLock()
+	names := make([]string, 0, len(initializers))
+	for name := range initializers {
+		names = append(names, name)
 	}
-	return fn(settings), nil
+	lock.RUnlock()
+	sort.Strings(names)
+	return names
 }
@@ -11,7 +11,9 @@ the root directory of this source tree.
 package format
 
 import (
+	"errors"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -86,6 +88,54 @@ func TestFormatFactory(t *testing.T) {
 			expected: "*format.AsciidocTable",
 			wantErr:  false,
 		},
+		{
+			name:     "format factory from name",
+			format:   "confluence",
+			expected: "*format.Confluence",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "csv",
+			expected: "*format.CSV",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "graph",
+			expected: "*format.GraphDOT",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "graph dot",
+			expected: "*format.GraphDOT",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "graph mermaid",
+			expected: "*format.GraphMermaid",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "hcl",
+			expected: "*format.HCL",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "hcl module",
+			expected: "*format.HCLModule",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "html",
+			expected: "*format.HTML",
+			wantErr:  false,
+		},
 		{
 			name:     "format factory from name",
 			format:   "json",
@@ -170,12 +220,54 @@ func TestFormatFactory(t *testing.T) {
 			expected: "*format.TfvarsJSON",
 			wantErr:  false,
 		},
+		{
+			name:     "format factory from name",
+			format:   "tfvars schema",
+			expected: "*format.TfvarsSchema",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "rst",
+			expected: "*format.RSTTable",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "rst table",
+			expected: "*format.RSTTable",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "rst tbl",
+			expected: "*format.RSTTable",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "rst document",
+			expected: "*format.RSTDocument",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "rst doc",
+			expected: "*format.RSTDocument",
+			wantErr:  false,
+		},
 		{
 			name:     "format factory from name",
 			format:   "toml",
 			expected: "*format.TOML",
 			wantErr:  false,
 		},
+		{
+			name:     "format factory from name",
+			format:   "tsv",
+			expected: "*format.CSV",
+			wantErr:  false,
+		},
 		{
 			name:     "format factory from name",
 			format:   "xml",
@@ -200,9 +292,102 @@ func TestFormatFactory(t *testing.T) {
 			assert := assert.New(t)
 			settings := &print.Settings{}
 			actual, err := Factory(tt.format, settings)
+			if tt.wantErr {
+				assert.True(errors.Is(err, ErrNotFound))
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, reflect.TypeOf(actual).String())
+			}
+		})
+	}
+}
+
+func TestFormatNames(t *testing.T) {
+	assert := assert.New(t)
+	names := Names()
+
+	assert.Equal(len(initializers), len(names))
+	assert.True(sort.StringsAreSorted(names))
+	assert.Contains(names, "markdown table")
+	assert.Contains(names, "tfvars hcl")
+}
+
+func TestFormatFactoryTemplateError(t *testing.T) {
+	assert := assert.New(t)
+	settings := print.DefaultSettings()
+	settings.Templates = map[string]string{
+		"inputs": `{{- showTOC -}}`,
+	}
+
+	_, err := Factory("markdown document", settings)
+	assert.Nil(err)
+
+	_, err = Factory("markdown table", settings)
+	assert.EqualError(err, "template: inputs:1: function \"showTOC\" not defined")
+}
+
+func TestFormatUnregister(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(Register("custom yaml", NewYAML))
+	assert.Contains(Names(), "custom yaml")
+
+	Unregister("custom yaml")
+	assert.NotContains(Names(), "custom yaml")
+
+	_, err := Factory("custom yaml", &print.Settings{})
+	assert.NotNil(err)
+}
+
+func TestFormatRegister(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		fn       func(*print.Settings) (print.Engine, error)
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "register custom formatter",
+			format:   "custom json",
+			fn:       NewJSON,
+			expected: "*format.JSON",
+			wantErr:  false,
+		},
+		{
+			name:     "register already registered formatter",
+			format:   "markdown",
+			fn:       NewJSON,
+			expected: "*format.MarkdownTable",
+			wantErr:  true,
+		},
+		{
+			name:     "register formatter with empty name",
+			format:   "",
+			fn:       NewJSON,
+			expected: "",
+			wantErr:  true,
+		},
+		{
+			name:     "register formatter with nil initializer",
+			format:   "custom nil",
+			fn:       nil,
+			expected: "",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			err := Register(tt.format, tt.fn)
 			if tt.wantErr {
 				assert.NotNil(err)
 			} else {
+				assert.Nil(err)
+				t.Cleanup(func() { Unregister(tt.format) })
+			}
+			if tt.expected != "" {
+				actual, err := Factory(tt.format, &print.Settings{})
 				assert.Nil(err)
 				assert.Equal(tt.expected, reflect.TypeOf(actual).String())
 			}
@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	htmltemplate "html/template"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/template"
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// Function represents a function available only in the templates of some of
+// the formatters, on top of the ones available in all the templates.
+type Function struct {
+	template.Function
+	Formatters []string
+}
+
+var (
+	documentFormatters = []string{"asciidoc document", "markdown document", "rst document"}
+	tableFormatters    = []string{"asciidoc table", "markdown table", "rst table"}
+	markdownFormatters = []string{"markdown document", "markdown table"}
+	rstFormatters      = []string{"rst document", "rst table"}
+)
+
+// functions is the documentation of the functions available in templates of
+// the formatters which accept overriding their templates.
+var functions = []*Function{
+	{template.Function{Name: "addresses", Signature: "addresses(addresses []string) string", Description: "Returns 'addresses' as comma separated inline codes, or 'n/a' if there is none."}, markdownFormatters},
+	{template.Function{Name: "align", Signature: "align(s string, i int) string", Description: "Pads 's' to align the values of the group of the i-th input."}, []string{"tfvars hcl"}},
+	{template.Function{Name: "colorize", Signature: "colorize(color string, s string) string", Description: "Returns 's' in ANSI 'color', e.g. '\\033[36m', if colors are enabled."}, []string{"pretty"}},
+	{template.Function{Name: "comment", Signature: "comment(i Input) string", Description: "Returns description and type of input 'i' as HCL comments."}, []string{"tfvars hcl"}},
+	{template.Function{Name: "diagram", Signature: "diagram(m Module) string", Description: "Returns Mermaid flowchart of module 'm' in a fenced code block."}, markdownFormatters},
+	{template.Function{Name: "hcl", Signature: "hcl(v Value) string", Description: "Returns 'v' in HCL."}, []string{"tfvars hcl"}},
+	{template.Function{Name: "heading", Signature: "heading(extra int, title string) string", Description: "Returns section 'title' underlined for configured indentation plus 'extra' levels."}, rstFormatters},
+	{template.Function{Name: "hyperlink", Signature: "hyperlink(text string, url string) string", Description: "Returns an anonymous hyperlink to 'url' with 'text'."}, rstFormatters},
+	{template.Function{Name: "isRequired", Signature: "isRequired() bool", Description: "Returns whether required inputs are marked."}, documentFormatters},
+	{template.Function{Name: "listTable", Signature: "listTable() string", Description: "Returns the directive of a 'list-table' with a header row."}, []string{"rst table"}},
+	{template.Function{Name: "literal", Signature: "literal(s string) string", Description: "Returns 's' as inline literal, or 'n/a' if it's empty."}, rstFormatters},
+	{template.Function{Name: "placeholder", Signature: "placeholder(i Input) string", Description: "Returns placeholder of input 'i' based on its type."}, []string{"tfvars hcl"}},
+	{template.Function{Name: "row", Signature: "row(cells ...string) string", Description: "Returns a row of 'list-table' with 'cells'."}, []string{"rst table"}},
+	{template.Function{Name: "showAnchor", Signature: "showAnchor() bool", Description: "Returns whether anchors are generated for items, which is also the case if TOC is shown."}, []string{"asciidoc document", "markdown document"}},
+	{template.Function{Name: "showDataFlow", Signature: "showDataFlow() bool", Description: "Returns whether the data flow section is shown."}, markdownFormatters},
+	{template.Function{Name: "showDiagram", Signature: "showDiagram() bool", Description: "Returns whether the diagram section is shown."}, markdownFormatters},
+	{template.Function{Name: "showInputReferences", Signature: "showInputReferences() bool", Description: "Returns whether the references of inputs are shown."}, []string{"markdown table"}},
+	{template.Function{Name: "showOutputSources", Signature: "showOutputSources() bool", Description: "Returns whether the sources of outputs are shown."}, []string{"markdown table"}},
+	{template.Function{Name: "showTOC", Signature: "showTOC() bool", Description: "Returns whether the table of contents is shown."}, []string{"asciidoc document", "markdown document"}},
+	{template.Function{Name: "type", Signature: "type(t string) string", Description: "Returns type 't' of an input as code."}, append(documentFormatters, tableFormatters...)},
+	{template.Function{Name: "usage", Signature: "usage(u string) string", Description: "Returns usage example 'u' as HCL code block."}, append(documentFormatters, tableFormatters...)},
+	{template.Function{Name: "value", Signature: "value(v string) string", Description: "Returns value 'v' of an item as code, or 'n/a' if there is none."}, append(documentFormatters, tableFormatters...)},
+	{template.Function{Name: "value", Signature: "value(v string) string", Description: "Returns value 'v' of an input, or an empty string if it's null."}, []string{"tfvars hcl"}},
+}
+
+// Functions returns the functions available only in the templates of some
+// of the formatters, sorted by name.
+func Functions() []*Function {
+	return functions
+}
+
+// htmlFuncs returns the functions shared by the templates of HTML-based
+// formatters, i.e. HTML and Confluence, along with their own 'funcs'.
+func htmlFuncs(settings *print.Settings, funcs htmltemplate.FuncMap) htmltemplate.FuncMap {
+	shared := htmltemplate.FuncMap{
+		"tostring": func(s types.String) string {
+			return string(s)
+		},
+		"valueOf": func(v interface {
+			GetValue() string
+			GetHCLValue() string
+		}) string {
+			if settings.ValueFormat == "hcl" {
+				return v.GetHCLValue()
+			}
+			return v.GetValue()
+		},
+		"ternary": func(condition bool, t string, f string) string {
+			if condition {
+				return t
+			}
+			return f
+		},
+	}
+	for name, fn := range funcs {
+		shared[name] = fn
+	}
+	return shared
+}
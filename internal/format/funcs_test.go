@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/template"
+)
+
+func TestFunctions(t *testing.T) {
+	assert := assert.New(t)
+
+	names := []string{}
+	for _, f := range Functions() {
+		names = append(names, f.Name)
+		assert.NotEmpty(f.Signature)
+		assert.NotEmpty(f.Description)
+		assert.NotEmpty(f.Formatters)
+
+		for _, name := range f.Formatters {
+			engine, err := Factory(name, print.DefaultSettings())
+			assert.Nil(err)
+			if tpl := templateOf(engine); assert.NotNil(tpl, name) {
+				assert.Contains(tpl.Funcs(), f.Name, name)
+			}
+		}
+	}
+	assert.True(sort.StringsAreSorted(names))
+}
+
+func templateOf(engine print.Engine) *template.Template {
+	switch e := engine.(type) {
+	case *AsciidocDocument:
+		return e.template
+	case *AsciidocTable:
+		return e.template
+	case *MarkdownDocument:
+		return e.template
+	case *MarkdownTable:
+		return e.template
+	case *Pretty:
+		return e.template
+	case *RSTDocument:
+		return e.template
+	case *RSTTable:
+		return e.template
+	case *TfvarsHCL:
+		return e.template
+	}
+	return nil
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+)
+
+// kinds of nodes of the graph
+const (
+	graphProvider = "provider"
+	graphResource = "resource"
+	graphData     = "data"
+	graphModule   = "module"
+	graphSource   = "source"
+)
+
+type graphNode struct {
+	id    string
+	kind  string
+	label string
+}
+
+type graphCluster struct {
+	label string
+	nodes []*graphNode
+}
+
+type graphEdge struct {
+	from  *graphNode
+	to    *graphNode
+	label string
+}
+
+// graph is the structure of a module: its providers, resources and data
+// sources grouped by their provider in clusters, and its module calls with
+// edges to their sources.
+type graph struct {
+	clusters []*graphCluster
+	nodes    []*graphNode
+	edges    []*graphEdge
+}
+
+// graphOf returns graph of 'module', with nodes of the sections which are
+// shown in 'settings'.
+func graphOf(module *terraform.Module, settings *print.Settings) *graph {
+	g := &graph{}
+	clusters := make(map[string]*graphCluster)
+	cluster := func(name string) *graphCluster {
+		if _, ok := clusters[name]; !ok {
+			clusters[name] = &graphCluster{label: name}
+		}
+		return clusters[name]
+	}
+
+	if settings.ShowProviders {
+		for _, p := range module.Providers {
+			c := cluster(p.Name)
+			c.nodes = append(c.nodes, &graphNode{
+				id:    "provider." + p.FullName(),
+				kind:  graphProvider,
+				label: p.FullName(),
+			})
+		}
+	}
+	if settings.ShowResources {
+		for _, r := range module.Resources {
+			node := &graphNode{
+				id:    r.FullType(),
+				kind:  graphResource,
+				label: r.FullType(),
+			}
+			if r.Mode == "data" {
+				node.id = "data." + node.id
+				node.kind = graphData
+				node.label = node.id
+			}
+			c := cluster(r.ProviderName)
+			c.nodes = append(c.nodes, node)
+		}
+	}
+	names := make([]string, 0, len(clusters))
+	for name := range clusters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		g.clusters = append(g.clusters, clusters[name])
+	}
+
+	if settings.ShowModuleCalls {
+		sources := make(map[string]*graphNode)
+		for _, m := range module.ModuleCalls {
+			node := &graphNode{
+				id:    "module." + m.Name,
+				kind:  graphModule,
+				label: "module." + m.Name,
+			}
+			g.nodes = append(g.nodes, node)
+
+			source, ok := sources[m.Source]
+			if !ok {
+				source = &graphNode{
+					id:    "source." + m.Source,
+					kind:  graphSource,
+					label: m.Source,
+				}
+				sources[m.Source] = source
+			}
+			g.edges = append(g.edges, &graphEdge{
+				from:  node,
+				to:    source,
+				label: m.Version,
+			})
+		}
+		// sources are added after module calls, in the order they're first called
+		for _, e := range g.edges {
+			if _, ok := sources[e.to.label]; ok {
+				g.nodes = append(g.nodes, e.to)
+				delete(sources, e.to.label)
+			}
+		}
+	}
+	return g
+}
+
+// graphDOTShapes are the shapes of each kind of nodes in DOT.
+var graphDOTShapes = map[string]string{
+	graphProvider: `shape="hexagon"`,
+	graphResource: `shape="box"`,
+	graphData:     `shape="box", style="dashed"`,
+	graphModule:   `shape="component"`,
+	graphSource:   `shape="folder"`,
+}
+
+// dot returns the graph in DOT language of Graphviz.
+func (g *graph) dot() string {
+	var b strings.Builder
+	node := func(n *graphNode, indent string) {
+		b.WriteString(fmt.Sprintf("%s%s [label=%s, %s]\n", indent, dotQuote(n.id), dotQuote(n.label), graphDOTShapes[n.kind]))
+	}
+
+	b.WriteString("digraph {\n")
+	b.WriteString("  rankdir=\"LR\"\n")
+	for i, c := range g.clusters {
+		b.WriteString("\n")
+		b.WriteString(fmt.Sprintf("  subgraph %s {\n", dotQuote(fmt.Sprintf("cluster_%d", i))))
+		b.WriteString(fmt.Sprintf("    label=%s\n", dotQuote(c.label)))
+		for _, n := range c.nodes {
+			node(n, "    ")
+		}
+		b.WriteString("  }\n")
+	}
+	if len(g.nodes) > 0 {
+		b.WriteString("\n")
+	}
+	for _, n := range g.nodes {
+		node(n, "  ")
+	}
+	if len(g.edges) > 0 {
+		b.WriteString("\n")
+	}
+	for _, e := range g.edges {
+		b.WriteString(fmt.Sprintf("  %s -> %s", dotQuote(e.from.id), dotQuote(e.to.id)))
+		if e.label != "" {
+			b.WriteString(fmt.Sprintf(" [label=%s]", dotQuote(e.label)))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// graphMermaidShapes are the opening and closing of shapes of each kind of
+// nodes in Mermaid.
+var graphMermaidShapes = map[string][2]string{
+	graphProvider: {"{{", "}}"},
+	graphResource: {"[", "]"},
+	graphData:     {"[(", ")]"},
+	graphModule:   {"[[", "]]"},
+	graphSource:   {"([", "])"},
+}
+
+// mermaid returns the graph as Mermaid flowchart. Nodes are given sequential
+// ids, as Mermaid doesn't support quoting them.
+func (g *graph) mermaid() string {
+	var b strings.Builder
+	ids := make(map[*graphNode]string)
+	counts := make(map[string]int)
+	id := func(n *graphNode) string {
+		if _, ok := ids[n]; !ok {
+			ids[n] = fmt.Sprintf("%s_%d", n.kind, counts[n.kind])
+			counts[n.kind]++
+		}
+		return ids[n]
+	}
+	node := func(n *graphNode, indent string) {
+		shape := graphMermaidShapes[n.kind]
+		b.WriteString(fmt.Sprintf("%s%s%s%s%s\n", indent, id(n), shape[0], mermaidQuote(n.label), shape[1]))
+	}
+
+	b.WriteString("flowchart LR\n")
+	for i, c := range g.clusters {
+		b.WriteString(fmt.Sprintf("  subgraph cluster_%d[%s]\n", i, mermaidQuote(c.label)))
+		for _, n := range c.nodes {
+			node(n, "    ")
+		}
+		b.WriteString("  end\n")
+	}
+	for _, n := range g.nodes {
+		node(n, "  ")
+	}
+	for _, e := range g.edges {
+		b.WriteString("  " + id(e.from) + " -->")
+		if e.label != "" {
+			b.WriteString("|" + mermaidQuote(e.label) + "|")
+		}
+		b.WriteString(" " + id(e.to) + "\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// graphMermaidBlock returns Mermaid flowchart of 'module' in a fenced code
+// block, to be embedded in Markdown.
+func graphMermaidBlock(module *terraform.Module, settings *print.Settings) string {
+	return fmt.Sprintf("\n\n```mermaid\n%s\n```\n", graphOf(module, settings).mermaid())
+}
+
+// dotQuote returns 's' as a quoted DOT identifier.
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+// mermaidQuote returns 's' as a quoted Mermaid text, in which double quotes
+// are replaced by their entity code.
+func mermaidQuote(s string) string {
+	return `"` + strings.NewReplacer(`"`, "#quot;", "\n", " ").Replace(s) + `"`
+}
+
+// GraphDOT represents DOT format of the graph of the module, which can be
+// rendered by Graphviz.
+type GraphDOT struct{}
+
+// NewGraphDOT returns new instance of GraphDOT.
+func NewGraphDOT(settings *print.Settings) (print.Engine, error) {
+	return &GraphDOT{}, nil
+}
+
+// Print a Terraform module as DOT graph.
+func (d *GraphDOT) Print(module *terraform.Module, settings *print.Settings) (string, error) {
+	return graphOf(module, settings).dot(), nil
+}
+
+// GraphMermaid represents Mermaid format of the graph of the module.
+type GraphMermaid struct{}
+
+// NewGraphMermaid returns new instance of GraphMermaid.
+func NewGraphMermaid(settings *print.Settings) (print.Engine, error) {
+	return &GraphMermaid{}, nil
+}
+
+// Print a Terraform module as Mermaid flowchart.
+func (m *GraphMermaid) Print(module *terraform.Module, settings *print.Settings) (string, error) {
+	return graphOf(module, settings).mermaid(), nil
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"graph":         NewGraphDOT,
+		"graph dot":     NewGraphDOT,
+		"graph mermaid": NewGraphMermaid,
+	})
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+)
+
+func TestGraphDOT(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	expected, err := testutil.GetExpected("graph", "dot")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewGraphDOT(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestGraphDOTOnlyModulecalls(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowModuleCalls: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("graph", "dot-OnlyModulecalls")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewGraphDOT(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestGraphDOTEmpty(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().Build()
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewGraphDOT(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal("digraph {\n  rankdir=\"LR\"\n}", actual)
+}
+
+func TestGraphMermaid(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	expected, err := testutil.GetExpected("graph", "mermaid")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewGraphMermaid(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestGraphMermaidOnlyResources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowResources: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("graph", "mermaid-OnlyResources")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewGraphMermaid(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestGraphMermaidEmpty(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().Build()
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewGraphMermaid(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal("flowchart LR", actual)
+}
+
+func TestGraphQuote(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		dot     string
+		mermaid string
+	}{
+		{
+			name:    "graph quote plain text",
+			text:    "foo",
+			dot:     `"foo"`,
+			mermaid: `"foo"`,
+		},
+		{
+			name:    "graph quote text with quotes",
+			text:    `git::https://example.com/"foo"`,
+			dot:     `"git::https://example.com/\"foo\""`,
+			mermaid: `"git::https://example.com/#quot;foo#quot;"`,
+		},
+		{
+			name:    "graph quote text with backslash",
+			text:    `foo\bar`,
+			dot:     `"foo\\bar"`,
+			mermaid: `"foo\bar"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.dot, dotQuote(tt.text))
+			assert.Equal(tt.mermaid, mermaidQuote(tt.text))
+		})
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// HCL represents HCL format of the interface of the module, which can seed a
+// wrapper module: a 'variable' block per each input, a 'module' block which
+// passes all the variables to the module, and an 'output' block per each
+// output of it.
+type HCL struct{}
+
+// NewHCL returns new instance of HCL.
+func NewHCL(settings *print.Settings) (print.Engine, error) {
+	return &HCL{}, nil
+}
+
+// Print a Terraform module as HCL of its interface.
+func (h *HCL) Print(module *terraform.Module, settings *print.Settings) (string, error) {
+	blocks := []string{}
+	if settings.ShowInputs {
+		for _, i := range module.Inputs {
+			blocks = append(blocks, hclVariable(i))
+		}
+	}
+	blocks = append(blocks, hclModuleCall(module, settings, true))
+	if settings.ShowOutputs {
+		for _, o := range module.Outputs {
+			blocks = append(blocks, hclOutput(module.CallerName(), o))
+		}
+	}
+	return strings.Join(blocks, "\n\n"), nil
+}
+
+// HCLModule represents HCL format of a 'module' block calling the module,
+// with all of its required inputs pre-filled with placeholders, the same as
+// the usage example of the module.
+type HCLModule struct{}
+
+// NewHCLModule returns new instance of HCLModule.
+func NewHCLModule(settings *print.Settings) (print.Engine, error) {
+	return &HCLModule{}, nil
+}
+
+// Print a Terraform module as HCL of a 'module' block calling it.
+func (h *HCLModule) Print(module *terraform.Module, settings *print.Settings) (string, error) {
+	return hclModuleCall(module, settings, false), nil
+}
+
+// hclVariable returns 'variable' block of input 'i'.
+func hclVariable(i *terraform.Input) string {
+	keys := []string{}
+	attributes := make(map[string]interface{})
+	if description := strings.TrimSpace(string(i.Description)); description != "" {
+		keys = append(keys, "description")
+		attributes["description"] = hclDescription(description)
+	}
+	if i.Type != "" {
+		keys = append(keys, "type")
+		attributes["type"] = types.Expression(i.Type)
+	}
+	if i.HasDefault() {
+		keys = append(keys, "default")
+		attributes["default"] = i.Default
+	}
+	return types.HCLBlock("variable", []string{i.Name}, keys, attributes)
+}
+
+// hclOutput returns 'output' block of output 'o', which exports the output of
+// the same name of the called module 'name'.
+func hclOutput(name string, o *terraform.Output) string {
+	keys := []string{}
+	attributes := make(map[string]interface{})
+	if description := strings.TrimSpace(string(o.Description)); description != "" {
+		keys = append(keys, "description")
+		attributes["description"] = hclDescription(description)
+	}
+	keys = append(keys, "value")
+	attributes["value"] = hclReference("module."+name, o.Name)
+	if o.Sensitive {
+		keys = append(keys, "sensitive")
+		attributes["sensitive"] = true
+	}
+	return types.HCLBlock("output", []string{o.Name}, keys, attributes)
+}
+
+// hclModuleCall returns 'module' block calling the module, with its source and
+// name resolved from the usage options. If 'wrapped' is set all the inputs are
+// passed from variables of the same name, otherwise only required inputs are
+// set to a placeholder based on their type.
+func hclModuleCall(module *terraform.Module, settings *print.Settings, wrapped bool) string {
+	return module.CallerBlock(func(i *terraform.Input) interface{} {
+		switch {
+		case !settings.ShowInputs:
+			return nil
+		case wrapped:
+			return hclReference("var", i.Name)
+		}
+		return terraform.RequiredPlaceholder(i)
+	})
+}
+
+// hclReference returns reference to attribute 'name' of 'object', with index
+// syntax if 'name' isn't a valid identifier.
+func hclReference(object string, name string) types.Expression {
+	if hclsyntax.ValidIdentifier(name) {
+		return types.Expression(object + "." + name)
+	}
+	return types.Expression(fmt.Sprintf("%s[%s]", object, types.HCL(name)))
+}
+
+// hclDescription returns 'description' as a quoted string, or as an indented
+// heredoc if it's multi-line.
+func hclDescription(description string) interface{} {
+	if !strings.Contains(description, "\n") {
+		return description
+	}
+	lines := strings.Split(description, "\n")
+
+	// delimiter must not appear as a line of the description
+	delimiter := "EOT"
+	for n := 1; ; n++ {
+		found := false
+		for _, line := range lines {
+			if strings.TrimSpace(line) == delimiter {
+				found = true
+				break
+			}
+		}
+		if !found {
+			break
+		}
+		delimiter = fmt.Sprintf("EOT%d", n)
+	}
+
+	var b strings.Builder
+	b.WriteString("<<-" + delimiter + "\n")
+	for _, line := range lines {
+		line = strings.TrimSuffix(line, "\r")
+		if strings.TrimSpace(line) != "" {
+			line = "    " + strings.NewReplacer("${", "$${", "%{", "%%{").Replace(line)
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("  " + delimiter)
+	return types.Expression(b.String())
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"hcl":        NewHCL,
+		"hcl module": NewHCLModule,
+	})
+}
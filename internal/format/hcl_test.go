@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+func TestHCL(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	expected, err := testutil.GetExpected("hcl", "hcl")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		Usage: &terraform.Usage{
+			Source: "terraform-docs/example/aws",
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewHCL(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+
+	_, diags := hclsyntax.ParseConfig([]byte(actual), "", hcl.Pos{Line: 1, Column: 1})
+	assert.False(diags.HasErrors())
+}
+
+func TestHCLSortByName(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SortByName: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("hcl", "hcl-SortByName")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		SortBy: &terraform.SortBy{
+			Name: true,
+		},
+		Usage: &terraform.Usage{
+			Source: "terraform-docs/example/aws",
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewHCL(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestHCLNoInputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowInputs:  false,
+		ShowOutputs: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("hcl", "hcl-NoInputs")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		Usage: &terraform.Usage{
+			Source: "terraform-docs/example/aws",
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewHCL(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestHCLNoOutputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowInputs:  true,
+		ShowOutputs: false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("hcl", "hcl-NoOutputs")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		Usage: &terraform.Usage{
+			Source: "terraform-docs/example/aws",
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewHCL(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestHCLModule(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	expected, err := testutil.GetExpected("hcl", "module")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		Usage: &terraform.Usage{
+			Name:    "foo",
+			Source:  "terraform-docs/example/aws",
+			Version: "1.0.0",
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewHCLModule(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+
+	_, diags := hclsyntax.ParseConfig([]byte(actual), "", hcl.Pos{Line: 1, Column: 1})
+	assert.False(diags.HasErrors())
+}
+
+func TestHCLModuleNoInputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowInputs: false,
+	}).Build()
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		Usage: &terraform.Usage{
+			Source: "terraform-docs/example/aws",
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewHCLModule(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal("module \"examples\" {\n  source = \"terraform-docs/example/aws\"\n}", actual)
+}
+
+func TestHCLOutputSensitive(t *testing.T) {
+	assert := assert.New(t)
+
+	actual := hclOutput("this", &terraform.Output{
+		Name:        "secret",
+		Description: types.String("The secret."),
+		Sensitive:   true,
+	})
+
+	assert.Equal("output \"secret\" {\n  description = \"The secret.\"\n  value       = module.this.secret\n  sensitive   = true\n}", actual)
+}
+
+func TestHCLDescription(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		expected    interface{}
+	}{
+		{
+			name:        "single line description",
+			description: "It's \"quoted\".",
+			expected:    "It's \"quoted\".",
+		},
+		{
+			name:        "multi line description",
+			description: "First line.  \n\n  Indented ${line}.",
+			expected:    types.Expression("<<-EOT\n    First line.  \n\n      Indented $${line}.\n  EOT"),
+		},
+		{
+			name:        "multi line description with delimiter",
+			description: "First line.\nEOT\nEOT1",
+			expected:    types.Expression("<<-EOT2\n    First line.\n    EOT\n    EOT1\n  EOT2"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, hclDescription(tt.description))
+		})
+	}
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+)
+
+const (
+	htmlTpl = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Terraform Module</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; line-height: 1.5; margin: 2em auto; max-width: 1200px; padding: 0 1em; color: #24292e; }
+pre, code { font-family: SFMono-Regular, Consolas, Menlo, monospace; font-size: 85%; }
+pre { margin: 0; white-space: pre-wrap; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 1em; }
+th, td { border: 1px solid #dfe2e5; padding: 6px 13px; text-align: left; vertical-align: top; }
+th { background: #f6f8fa; }
+table.sortable th { cursor: pointer; user-select: none; }
+table.sortable th[aria-sort="ascending"]::after { content: " \25B2"; }
+table.sortable th[aria-sort="descending"]::after { content: " \25BC"; }
+tr:target { background: #fffbdd; }
+input.filter { margin-bottom: .5em; padding: 4px 8px; width: 20em; }
+.description { white-space: pre-wrap; }
+.header { white-space: pre-wrap; }
+</style>
+</head>
+<body>
+{{- if and .Settings.ShowHeader .Module.Header }}
+<section id="header">
+<div class="header">{{ .Module.Header }}</div>
+</section>
+{{- end }}
+{{- with .Module.Usage }}
+<section id="usage">
+<h2>Usage</h2>
+<pre><code class="language-hcl">{{ . }}</code></pre>
+</section>
+{{- end }}
+{{- if .Settings.ShowRequirements }}
+<section id="requirements">
+<h2>Requirements</h2>
+{{- if not .Module.Requirements }}
+<p>No requirements.</p>
+{{- else }}
+<table>
+<thead><tr><th>Name</th><th>Version</th></tr></thead>
+<tbody>
+{{- range .Module.Requirements }}
+<tr id="requirement_{{ .Name }}"><td>{{ .Name }}</td><td>{{ .Version }}</td></tr>
+{{- end }}
+</tbody>
+</table>
+{{- end }}
+</section>
+{{- end }}
+{{- if .Settings.ShowProviders }}
+<section id="providers">
+<h2>Providers</h2>
+{{- if not .Module.Providers }}
+<p>No providers.</p>
+{{- else }}
+<table>
+<thead><tr><th>Name</th><th>Version</th></tr></thead>
+<tbody>
+{{- range .Module.Providers }}
+<tr id="provider_{{ .FullName }}"><td>{{ .FullName }}</td><td>{{ .Version }}</td></tr>
+{{- end }}
+</tbody>
+</table>
+{{- end }}
+</section>
+{{- end }}
+{{- if .Settings.ShowModuleCalls }}
+<section id="modules">
+<h2>Modules</h2>
+{{- if not .Module.ModuleCalls }}
+<p>No modules.</p>
+{{- else }}
+<table>
+<thead><tr><th>Name</th><th>Source</th><th>Version</th></tr></thead>
+<tbody>
+{{- range .Module.ModuleCalls }}
+<tr id="module_{{ .Name }}"><td>{{ .Name }}</td><td>{{ .Source }}</td><td>{{ .Version }}</td></tr>
+{{- end }}
+</tbody>
+</table>
+{{- end }}
+</section>
+{{- end }}
+{{- if .Settings.ShowResources }}
+<section id="resources">
+<h2>Resources</h2>
+{{- if not .Module.Resources }}
+<p>No resources.</p>
+{{- else }}
+<table>
+<thead><tr><th>Name</th><th>Type</th></tr></thead>
+<tbody>
+{{- range .Module.Resources }}
+<tr><td>{{ if .URL }}<a href="{{ .URL }}">{{ .FullType }}</a>{{ else }}{{ .FullType }}{{ end }}</td><td>{{ if eq .Mode "data" }}data source{{ else }}resource{{ end }}</td></tr>
+{{- end }}
+</tbody>
+</table>
+{{- end }}
+</section>
+{{- end }}
+{{- if .Settings.ShowInputs }}
+<section id="inputs">
+<h2>Inputs</h2>
+{{- if not .Module.Inputs }}
+<p>No inputs.</p>
+{{- else }}
+<input type="search" class="filter" data-table="inputs-table" placeholder="Filter inputs" aria-label="Filter inputs">
+<table id="inputs-table" class="sortable">
+<thead><tr><th>Name</th><th>Description</th><th>Type</th><th>Default</th>{{ if .Settings.ShowRequired }}<th>Required</th>{{ end }}</tr></thead>
+<tbody>
+{{- range .Module.Inputs }}
+<tr id="input_{{ .Name }}"><td><a href="#input_{{ .Name }}">{{ .Name }}</a></td><td class="description">{{ tostring .Description }}</td><td>{{ code "type" (tostring .Type) }}</td><td>{{ if .HasDefault }}{{ code "value" (valueOf .) }}{{ else }}n/a{{ end }}</td>{{ if $.Settings.ShowRequired }}<td>{{ ternary .Required "yes" "no" }}</td>{{ end }}</tr>
+{{- end }}
+</tbody>
+</table>
+{{- end }}
+</section>
+{{- end }}
+{{- if .Settings.ShowOutputs }}
+<section id="outputs">
+<h2>Outputs</h2>
+{{- if not .Module.Outputs }}
+<p>No outputs.</p>
+{{- else }}
+<input type="search" class="filter" data-table="outputs-table" placeholder="Filter outputs" aria-label="Filter outputs">
+<table id="outputs-table" class="sortable">
+<thead><tr><th>Name</th><th>Description</th>{{ if .Settings.OutputValues }}<th>Value</th>{{ if .Settings.ShowSensitivity }}<th>Sensitive</th>{{ end }}{{ end }}</tr></thead>
+<tbody>
+{{- range .Module.Outputs }}
+<tr id="output_{{ .Name }}"><td><a href="#output_{{ .Name }}">{{ .Name }}</a></td><td class="description">{{ tostring .Description }}</td>{{ if $.Settings.OutputValues }}<td>{{ code "value" (valueOf .) }}</td>{{ if $.Settings.ShowSensitivity }}<td>{{ ternary .Sensitive "yes" "no" }}</td>{{ end }}{{ end }}</tr>
+{{- end }}
+</tbody>
+</table>
+{{- end }}
+</section>
+{{- end }}
+<script>
+(function () {
+  document.querySelectorAll("input.filter").forEach(function (input) {
+    var table = document.getElementById(input.dataset.table);
+    input.addEventListener("input", function () {
+      var query = input.value.toLowerCase();
+      table.querySelectorAll("tbody tr").forEach(function (row) {
+        row.hidden = row.textContent.toLowerCase().indexOf(query) === -1;
+      });
+    });
+  });
+  document.querySelectorAll("table.sortable").forEach(function (table) {
+    var headers = table.querySelectorAll("thead th");
+    headers.forEach(function (th, index) {
+      th.addEventListener("click", function () {
+        var ascending = th.getAttribute("aria-sort") !== "ascending";
+        headers.forEach(function (h) { h.removeAttribute("aria-sort"); });
+        th.setAttribute("aria-sort", ascending ? "ascending" : "descending");
+        var tbody = table.tBodies[0];
+        Array.prototype.slice.call(tbody.rows).sort(function (a, b) {
+          var x = a.cells[index].textContent.trim();
+          var y = b.cells[index].textContent.trim();
+          return (ascending ? 1 : -1) * x.localeCompare(y, undefined, { numeric: true });
+        }).forEach(function (row) { tbody.appendChild(row); });
+      });
+    });
+  });
+})();
+</script>
+</body>
+</html>`
+)
+
+// HTML represents HTML format.
+type HTML struct {
+	template *htmltemplate.Template
+}
+
+// NewHTML returns new instance of HTML.
+func NewHTML(settings *print.Settings) (print.Engine, error) {
+	tt := htmltemplate.Must(htmltemplate.New("html").Funcs(htmlFuncs(settings, htmltemplate.FuncMap{
+		"code": htmlCode,
+	})).Parse(htmlTpl))
+	return &HTML{
+		template: tt,
+	}, nil
+}
+
+// Print a Terraform module as a standalone HTML page. All the content of the
+// module is escaped by html/template based on its context in the page.
+func (h *HTML) Print(module *terraform.Module, settings *print.Settings) (string, error) {
+	buffer := new(bytes.Buffer)
+	err := h.template.Execute(buffer, struct {
+		Module   *terraform.Module
+		Settings *print.Settings
+	}{
+		Module:   module,
+		Settings: settings,
+	})
+	if err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+// htmlCode returns 'code' as an inline code, or as a collapsible code block
+// if it's multi-line (e.g. nested object types or values). The first line is
+// used as summary of the collapsed block.
+func htmlCode(class string, code string) htmltemplate.HTML {
+	escaped := htmltemplate.HTMLEscapeString(code)
+	if !strings.Contains(code, "\n") {
+		return htmltemplate.HTML("<code class=\"" + class + "\">" + escaped + "</code>") //nolint:gosec
+	}
+	summary := htmltemplate.HTMLEscapeString(strings.SplitN(code, "\n", 2)[0])
+	return htmltemplate.HTML("<details><summary><code>" + summary + " ...</code></summary><pre class=\"" + class + "\">" + escaped + "</pre></details>") //nolint:gosec
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"html": NewHTML,
+	})
+}
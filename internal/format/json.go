@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/schema"
 	"github.com/terraform-docs/terraform-docs/internal/terraform"
 )
 
@@ -23,8 +24,8 @@ import (
 type JSON struct{}
 
 // NewJSON returns new instance of JSON.
-func NewJSON(settings *print.Settings) print.Engine {
-	return &JSON{}
+func NewJSON(settings *print.Settings) (print.Engine, error) {
+	return &JSON{}, nil
 }
 
 // Print a Terraform module as json.
@@ -60,6 +61,9 @@ func (j *JSON) Print(module *terraform.Module, settings *print.Settings) (string
 	if settings.ShowResources {
 		copy.Resources = module.Resources
 	}
+	if settings.ShowDataFlow {
+		copy.DataFlow = module.DataFlow
+	}
 
 	buffer := new(bytes.Buffer)
 
@@ -67,7 +71,7 @@ func (j *JSON) Print(module *terraform.Module, settings *print.Settings) (string
 	encoder.SetIndent("", "  ")
 	encoder.SetEscapeHTML(settings.EscapeCharacters)
 
-	err := encoder.Encode(copy)
+	err := encoder.Encode(schema.Versioned(copy, settings.SchemaVersion))
 	if err != nil {
 		return "", err
 	}
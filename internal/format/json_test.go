@@ -31,7 +31,8 @@ func TestJson(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -57,7 +58,8 @@ func TestJsonSortByName(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -85,7 +87,8 @@ func TestJsonSortByRequired(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -111,7 +114,8 @@ func TestJsonSortByType(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -137,7 +141,8 @@ func TestJsonNoHeader(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -163,7 +168,8 @@ func TestJsonNoInputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -189,7 +195,8 @@ func TestJsonNoModulecalls(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -215,7 +222,8 @@ func TestJsonNoOutputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -241,7 +249,8 @@ func TestJsonNoProviders(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -267,7 +276,8 @@ func TestJsonNoRequirements(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -292,7 +302,8 @@ func TestJsonNoReources(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -318,7 +329,8 @@ func TestJsonOnlyHeader(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -344,7 +356,8 @@ func TestJsonOnlyInputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -370,7 +383,8 @@ func TestJsonOnlyModulecalls(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -396,7 +410,8 @@ func TestJsonOnlyOutputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -422,7 +437,8 @@ func TestJsonOnlyProviders(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -448,7 +464,8 @@ func TestJsonOnlyRequirements(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -474,7 +491,8 @@ func TestJsonOnlyResources(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -494,7 +512,8 @@ func TestJsonEscapeCharacters(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -519,7 +538,107 @@ func TestJsonOutputValues(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonSchemaVersion2(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SchemaVersion: 2,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-SchemaVersion2")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonDataFlow(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SchemaVersion: 2,
+		ShowDataFlow:  true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-DataFlow")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().WithOverwrite(&terraform.Options{
+		DataFlow: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonOutputSources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SchemaVersion:     2,
+		ShowOutputSources: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-OutputSources")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().WithOverwrite(&terraform.Options{
+		OutputSources: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonInputReferences(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SchemaVersion:       2,
+		ShowInputReferences: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-InputReferences")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().WithOverwrite(&terraform.Options{
+		InputReferences: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -569,7 +688,8 @@ func TestJsonHeaderFromFile(t *testing.T) {
 			module, err := testutil.GetModule(options)
 			assert.Nil(err)
 
-			printer := NewJSON(settings)
+			printer, err := NewJSON(settings)
+			assert.Nil(err)
 			actual, err := printer.Print(module, settings)
 
 			assert.Nil(err)
@@ -602,7 +722,8 @@ func TestJsonEmpty(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewJSON(settings)
+	printer, err := NewJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
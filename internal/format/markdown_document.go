@@ -27,6 +27,69 @@ const (
 		{{- end -}}
 	{{ end -}}
 	`
+	documentUsageTpl = `
+	{{- with .Module.Usage -}}
+		{{ indent 0 "#" }} Usage
+		{{ usage . }}
+	{{ end -}}
+	`
+	documentDiagramTpl = `
+	{{- if showDiagram -}}
+		{{ indent 0 "#" }} Diagram
+		{{ diagram .Module }}
+	{{ end -}}
+	`
+	documentTOCTpl = `
+	{{- if showTOC -}}
+		{{ indent 0 "#" }} Contents
+		{{ if .Module.Usage }}
+			- [Usage](#usage)
+		{{- end }}
+		{{- if showDiagram }}
+			- [Diagram](#diagram)
+		{{- end }}
+		{{- if .Settings.ShowRequirements }}
+			- [Requirements](#requirements)
+		{{- end }}
+		{{- if .Settings.ShowProviders }}
+			- [Providers](#providers)
+		{{- end }}
+		{{- if .Settings.ShowModuleCalls }}
+			- [Modules](#modules)
+		{{- end }}
+		{{- if .Settings.ShowResources }}
+			- [Resources](#resources)
+		{{- end }}
+		{{- if .Settings.ShowInputs }}
+			{{- if .Settings.ShowRequired }}
+				- [Required Inputs](#required-inputs)
+				{{- range .Module.RequiredInputs }}
+					{{ "  " }}- [{{ name .Name }}](#{{ printf "input_%s" .Name | name }})
+				{{- end }}
+				- [Optional Inputs](#optional-inputs)
+				{{- range .Module.OptionalInputs }}
+					{{ "  " }}- [{{ name .Name }}](#{{ printf "input_%s" .Name | name }})
+				{{- end }}
+			{{- else }}
+				- [Inputs](#inputs)
+				{{- range .Module.Inputs }}
+					{{ "  " }}- [{{ name .Name }}](#{{ printf "input_%s" .Name | name }})
+				{{- end }}
+			{{- end }}
+		{{- end }}
+		{{- if .Settings.ShowOutputs }}
+			- [Outputs](#outputs)
+			{{- range .Module.Outputs }}
+				{{ "  " }}- [{{ name .Name }}](#{{ printf "output_%s" .Name | name }})
+			{{- end }}
+		{{- end }}
+		{{- if showDataFlow }}
+			- [Data flow](#data-flow)
+		{{- end }}
+	{{ printf "\n" }}
+	{{ end -}}
+	`
+
 	documentResourcesTpl = `
 	{{- if .Settings.ShowResources -}}
 		{{ indent 0 "#" }} Resources
@@ -112,14 +175,14 @@ const (
 
 	documentInputTpl = `
 	{{ printf "\n" }}
-	{{ indent 1 "#" }} {{ name .Name }}
+	{{ indent 1 "#" }} {{ if showAnchor }}<a name="input_{{ .Name }}"></a> {{ end }}{{ name .Name }}
 
 	Description: {{ tostring .Description | sanitizeDoc }}
 
 	Type: {{ tostring .Type | type }}
 
 	{{ if or .HasDefault (not isRequired) }}
-		Default: {{ default "n/a" .GetValue | value }}
+		Default: {{ default "n/a" (valueOf .) | value }}
 	{{- end }}
 	`
 
@@ -132,12 +195,12 @@ const (
 			The following outputs are exported:
 			{{- range .Module.Outputs }}
 
-				{{ indent 1 "#" }} {{ name .Name }}
+				{{ indent 1 "#" }} {{ if showAnchor }}<a name="output_{{ .Name }}"></a> {{ end }}{{ name .Name }}
 
 				Description: {{ tostring .Description | sanitizeDoc }}
 
 				{{ if $.Settings.OutputValues }}
-					{{- $sensitive := ternary .Sensitive "<sensitive>" .GetValue -}}
+					{{- $sensitive := ternary .Sensitive "<sensitive>" (valueOf .) -}}
 					Value: {{ value $sensitive | sanitizeDoc }}
 
 					{{ if $.Settings.ShowSensitivity -}}
@@ -149,6 +212,24 @@ const (
 	{{ end -}}
 	`
 
+	documentDataflowTpl = `
+	{{- if showDataFlow -}}
+		{{ indent 0 "#" }} Data flow
+		{{ if not .Module.Inputs }}
+			No input.
+		{{ else if not .Module.DataFlowKnown }}
+			Data flow is unknown, as ` + "`.tf.json`" + ` files are not parsed.
+		{{ else }}
+			The following resources and outputs are affected by each input:
+			{{ range .Module.Inputs }}
+				- {{ name .Name }}
+				{{ "  " }}- Resources: {{ $.Module.AffectedResources .Name | addresses }}
+				{{ "  " }}- Outputs: {{ $.Module.AffectedOutputs .Name | addresses }}
+			{{ end }}
+		{{ end }}
+	{{ end -}}
+	`
+
 	documentModulecallsTpl = `
 	{{- if .Settings.ShowModuleCalls -}}
 		{{ indent 0 "#" }} Modules
@@ -171,12 +252,16 @@ const (
 
 	documentTpl = `
 	{{- template "header" . -}}
+	{{- template "toc" . -}}
+	{{- template "usage" . -}}
+	{{- template "diagram" . -}}
 	{{- template "requirements" . -}}
 	{{- template "providers" . -}}
 	{{- template "modulecalls" . -}}
 	{{- template "resources" . -}}
 	{{- template "inputs" . -}}
 	{{- template "outputs" . -}}
+	{{- template "dataflow" . -}}
 	`
 )
 
@@ -186,13 +271,63 @@ type MarkdownDocument struct {
 }
 
 // NewMarkdownDocument returns new instance of Document.
-func NewMarkdownDocument(settings *print.Settings) print.Engine {
-	tt := template.New(settings, &template.Item{
+func NewMarkdownDocument(settings *print.Settings) (print.Engine, error) {
+	tt, err := template.New(settings, gotemplate.FuncMap{
+		"type": func(t string) string {
+			result, extraline := printFencedCodeBlock(t, "hcl")
+			if !extraline {
+				result += "\n"
+			}
+			return result
+		},
+		"value": func(v string) string {
+			if v == "n/a" {
+				return v
+			}
+			result, extraline := printFencedCodeBlock(v, valueLanguage(settings))
+			if !extraline {
+				result += "\n"
+			}
+			return result
+		},
+		"isRequired": func() bool {
+			return settings.ShowRequired
+		},
+		"showAnchor": func() bool {
+			return settings.ShowAnchor || settings.ShowTOC
+		},
+		"showTOC": func() bool {
+			return settings.ShowTOC
+		},
+		"usage": func(u string) string {
+			usage, _ := printFencedCodeBlock(u, "hcl")
+			return usage
+		},
+		"showDiagram": func() bool {
+			return settings.ShowDiagram
+		},
+		"diagram": func(m *terraform.Module) string {
+			return graphMermaidBlock(m, settings)
+		},
+		"showDataFlow": func() bool {
+			return settings.ShowDataFlow
+		},
+		"addresses": printAddresses,
+	}, &template.Item{
 		Name: "document",
 		Text: documentTpl,
 	}, &template.Item{
 		Name: "header",
 		Text: documentHeaderTpl,
+	}, &template.Item{
+		Name: "usage",
+		Text: documentUsageTpl,
+	}, &template.Item{
+		Name: "diagram",
+		Text: documentDiagramTpl,
+	}, &template.Item{
+		Name: "toc",
+		Text: documentTOCTpl,
 	}, &template.Item{
 		Name: "requirements",
 		Text: documentRequirementsTpl,
@@ -211,35 +346,19 @@ func NewMarkdownDocument(settings *print.Settings) print.Engine {
 	}, &template.Item{
 		Name: "outputs",
 		Text: documentOutputsTpl,
+	}, &template.Item{
+		Name: "dataflow",
+		Text: documentDataflowTpl,
 	}, &template.Item{
 		Name: "modulecalls",
 		Text: documentModulecallsTpl,
 	})
-	tt.CustomFunc(gotemplate.FuncMap{
-		"type": func(t string) string {
-			result, extraline := printFencedCodeBlock(t, "hcl")
-			if !extraline {
-				result += "\n"
-			}
-			return result
-		},
-		"value": func(v string) string {
-			if v == "n/a" {
-				return v
-			}
-			result, extraline := printFencedCodeBlock(v, "json")
-			if !extraline {
-				result += "\n"
-			}
-			return result
-		},
-		"isRequired": func() bool {
-			return settings.ShowRequired
-		},
-	})
+	if err != nil {
+		return nil, err
+	}
 	return &MarkdownDocument{
 		template: tt,
-	}
+	}, nil
 }
 
 // Print a Terraform module as Markdown document.
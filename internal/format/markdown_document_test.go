@@ -31,7 +31,8 @@ func TestDocument(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -51,7 +52,8 @@ func TestDocumentWithRequired(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -77,7 +79,8 @@ func TestDocumentSortByName(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -105,7 +108,8 @@ func TestDocumentSortByRequired(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -131,7 +135,8 @@ func TestDocumentSortByType(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -157,7 +162,8 @@ func TestDocumentNoHeader(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -183,7 +189,8 @@ func TestDocumentNoInputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -208,7 +215,8 @@ func TestDocumentNoModulecalls(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -234,7 +242,8 @@ func TestDocumentNoOutputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -260,7 +269,8 @@ func TestDocumentNoProviders(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -286,7 +296,8 @@ func TestDocumentNoRequirements(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -311,7 +322,8 @@ func TestDocumentNoResources(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -337,7 +349,8 @@ func TestDocumentOnlyHeader(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -363,7 +376,8 @@ func TestDocumentOnlyInputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -388,7 +402,8 @@ func TestDocumentOnlyModulecalls(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -414,7 +429,8 @@ func TestDocumentOnlyOutputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -440,7 +456,8 @@ func TestDocumentOnlyProviders(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -466,7 +483,8 @@ func TestDocumentOnlyRequirements(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -491,7 +509,8 @@ func TestDocumentOnlyResources(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -511,7 +530,8 @@ func TestDocumentEscapeCharacters(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -531,7 +551,8 @@ func TestDocumentIndentationBelowAllowed(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -551,7 +572,8 @@ func TestDocumentIndentationAboveAllowed(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -571,7 +593,8 @@ func TestDocumentIndentationOfFour(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -597,7 +620,109 @@ func TestDocumentOutputValues(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentValueFormatHCL(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		ShowSensitivity: true,
+		ValueFormat:     "hcl",
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-ValueFormatHCL")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		OutputValues:     true,
+		OutputValuesPath: "output_values.json",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentTOC(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		EscapeCharacters: true,
+		ShowRequired:     true,
+		ShowTOC:          true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-TOC")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentTOCWithoutRequired(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowHeader: true,
+		ShowInputs: true,
+		ShowTOC:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-TOCWithoutRequired")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentAnchor(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowAnchor: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-Anchor")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -647,7 +772,8 @@ func TestDocumentHeaderFromFile(t *testing.T) {
 			module, err := testutil.GetModule(options)
 			assert.Nil(err)
 
-			printer := NewMarkdownDocument(settings)
+			printer, err := NewMarkdownDocument(settings)
+			assert.Nil(err)
 			actual, err := printer.Print(module, settings)
 
 			assert.Nil(err)
@@ -675,7 +801,83 @@ func TestDocumentOutputValuesNoSensitivity(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentUsage(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-Usage")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		ShowUsage: true,
+		Usage: &terraform.Usage{
+			Source:   "terraform-docs/example/aws",
+			Version:  "1.0.0",
+			Optional: true,
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentDiagram(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowDiagram: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-Diagram")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentDataFlow(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowDataFlow: true,
+		ShowTOC:      true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-DataFlow")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().WithOverwrite(&terraform.Options{
+		DataFlow: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -701,7 +903,8 @@ func TestDocumentEmpty(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownDocument(settings)
+	printer, err := NewMarkdownDocument(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -27,6 +27,18 @@ const (
 		{{- end -}}
 	{{ end -}}
 	`
+	tableUsageTpl = `
+	{{- with .Module.Usage -}}
+		{{ indent 0 "#" }} Usage
+		{{ usage . }}
+	{{ end -}}
+	`
+	tableDiagramTpl = `
+	{{- if showDiagram -}}
+		{{ indent 0 "#" }} Diagram
+		{{ diagram .Module }}
+	{{ end -}}
+	`
 	tableResourcesTpl = `
 	{{- if .Settings.ShowResources -}}
 		{{ indent 0 "#" }} Resources
@@ -82,10 +94,13 @@ const (
 		{{ if not .Module.Inputs }}
 			No input.
 		{{ else }}
-			| Name | Description | Type | Default |{{ if .Settings.ShowRequired }} Required |{{ end }}
-			|------|-------------|------|---------|{{ if .Settings.ShowRequired }}:--------:|{{ end }}
+			| Name | Description | Type | Default |{{ if showInputReferences }} Used by |{{ end }}{{ if .Settings.ShowRequired }} Required |{{ end }}
+			|------|-------------|------|---------|{{ if showInputReferences }}---------|{{ end }}{{ if .Settings.ShowRequired }}:--------:|{{ end }}
 			{{- range .Module.Inputs }}
-				| {{ name .Name }} | {{ tostring .Description | sanitizeTbl }} | {{ tostring .Type | type | sanitizeTbl }} | {{ value .GetValue | sanitizeTbl }} |
+				| {{ name .Name }} | {{ tostring .Description | sanitizeTbl }} | {{ tostring .Type | type | sanitizeTbl }} | {{ value (valueOf .) | sanitizeTbl }} |
+				{{- if showInputReferences -}}
+					{{ printf " " }}{{ ternary .Unused "**unused**" (addresses .UsedBy) }} |
+				{{- end -}}
 				{{- if $.Settings.ShowRequired -}}
 					{{ printf " " }}{{ ternary .Required "yes" "no" }} |
 				{{- end -}}
@@ -100,12 +115,16 @@ const (
 		{{ if not .Module.Outputs }}
 			No output.
 		{{ else }}
-			| Name | Description |{{ if .Settings.OutputValues }} Value |{{ if $.Settings.ShowSensitivity }} Sensitive |{{ end }}{{ end }}
-			|------|-------------|{{ if .Settings.OutputValues }}-------|{{ if $.Settings.ShowSensitivity }}:---------:|{{ end }}{{ end }}
+			{{- $sources := and showOutputSources .Module.OutputSourcesKnown }}
+			| Name | Description |{{ if $sources }} Derived from |{{ end }}{{ if .Settings.OutputValues }} Value |{{ if $.Settings.ShowSensitivity }} Sensitive |{{ end }}{{ end }}
+			|------|-------------|{{ if $sources }}--------------|{{ end }}{{ if .Settings.OutputValues }}-------|{{ if $.Settings.ShowSensitivity }}:---------:|{{ end }}{{ end }}
 			{{- range .Module.Outputs }}
 				| {{ name .Name }} | {{ tostring .Description | sanitizeTbl }} |
+				{{- if $sources -}}
+					{{ printf " " }}{{ addresses .Sources }} |
+				{{- end -}}
 				{{- if $.Settings.OutputValues -}}
-					{{- $sensitive := ternary .Sensitive "<sensitive>" .GetValue -}}
+					{{- $sensitive := ternary .Sensitive "<sensitive>" (valueOf .) -}}
 					{{ printf " " }}{{ value $sensitive | sanitizeTbl }} |
 					{{- if $.Settings.ShowSensitivity -}}
 						{{ printf " " }}{{ ternary .Sensitive "yes" "no" }} |
@@ -116,6 +135,23 @@ const (
 	{{ end -}}
 	`
 
+	tableDataflowTpl = `
+	{{- if showDataFlow -}}
+		{{ indent 0 "#" }} Data flow
+		{{ if not .Module.Inputs }}
+			No input.
+		{{ else if not .Module.DataFlowKnown }}
+			Data flow is unknown, as ` + "`.tf.json`" + ` files are not parsed.
+		{{ else }}
+			| Input | Resources | Outputs |
+			|-------|-----------|---------|
+			{{- range .Module.Inputs }}
+				| {{ name .Name }} | {{ $.Module.AffectedResources .Name | addresses }} | {{ $.Module.AffectedOutputs .Name | addresses }} |
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
 	tableModulecallsTpl = `
 	{{- if .Settings.ShowModuleCalls -}}
 		{{ indent 0 "#" }} Modules
@@ -133,12 +169,15 @@ const (
 
 	tableTpl = `
 	{{- template "header" . -}}
+	{{- template "usage" . -}}
+	{{- template "diagram" . -}}
 	{{- template "requirements" . -}}
 	{{- template "providers" . -}}
 	{{- template "modulecalls" . -}}
 	{{- template "resources" . -}}
 	{{- template "inputs" . -}}
 	{{- template "outputs" . -}}
+	{{- template "dataflow" . -}}
 	`
 )
 
@@ -148,13 +187,51 @@ type MarkdownTable struct {
 }
 
 // NewMarkdownTable returns new instance of Table.
-func NewMarkdownTable(settings *print.Settings) print.Engine {
-	tt := template.New(settings, &template.Item{
+func NewMarkdownTable(settings *print.Settings) (print.Engine, error) {
+	tt, err := template.New(settings, gotemplate.FuncMap{
+		"type": func(t string) string {
+			inputType, _ := printFencedCodeBlock(t, "")
+			return inputType
+		},
+		"value": func(v string) string {
+			var result = "n/a"
+			if v != "" {
+				result, _ = printFencedCodeBlock(v, "")
+			}
+			return result
+		},
+		"usage": func(u string) string {
+			usage, _ := printFencedCodeBlock(u, "hcl")
+			return usage
+		},
+		"showDiagram": func() bool {
+			return settings.ShowDiagram
+		},
+		"diagram": func(m *terraform.Module) string {
+			return graphMermaidBlock(m, settings)
+		},
+		"showDataFlow": func() bool {
+			return settings.ShowDataFlow
+		},
+		"addresses": printAddresses,
+		"showOutputSources": func() bool {
+			return settings.ShowOutputSources
+		},
+		"showInputReferences": func() bool {
+			return settings.ShowInputReferences
+		},
+	}, &template.Item{
 		Name: "table",
 		Text: tableTpl,
 	}, &template.Item{
 		Name: "header",
 		Text: tableHeaderTpl,
+	}, &template.Item{
+		Name: "usage",
+		Text: tableUsageTpl,
+	}, &template.Item{
+		Name: "diagram",
+		Text: tableDiagramTpl,
 	}, &template.Item{
 		Name: "requirements",
 		Text: tableRequirementsTpl,
@@ -170,26 +247,19 @@ func NewMarkdownTable(settings *print.Settings) print.Engine {
 	}, &template.Item{
 		Name: "outputs",
 		Text: tableOutputsTpl,
+	}, &template.Item{
+		Name: "dataflow",
+		Text: tableDataflowTpl,
 	}, &template.Item{
 		Name: "modulecalls",
 		Text: tableModulecallsTpl,
 	})
-	tt.CustomFunc(gotemplate.FuncMap{
-		"type": func(t string) string {
-			inputType, _ := printFencedCodeBlock(t, "")
-			return inputType
-		},
-		"value": func(v string) string {
-			var result = "n/a"
-			if v != "" {
-				result, _ = printFencedCodeBlock(v, "")
-			}
-			return result
-		},
-	})
+	if err != nil {
+		return nil, err
+	}
 	return &MarkdownTable{
 		template: tt,
-	}
+	}, nil
 }
 
 // Print a Terraform module as Markdown tables.
@@ -11,11 +11,13 @@ the root directory of this source tree.
 package format
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/template"
 	"github.com/terraform-docs/terraform-docs/internal/terraform"
 	"github.com/terraform-docs/terraform-docs/internal/testutil"
 )
@@ -31,7 +33,8 @@ func TestTable(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -51,7 +54,8 @@ func TestTableWithRequired(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -77,7 +81,8 @@ func TestTableSortByName(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -105,7 +110,8 @@ func TestTableSortByRequired(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -131,7 +137,8 @@ func TestTableSortByType(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -157,7 +164,8 @@ func TestTableNoHeader(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -183,7 +191,8 @@ func TestTableNoInputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -209,7 +218,8 @@ func TestTableNoModulecalls(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -235,7 +245,8 @@ func TestTableNoOutputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -261,7 +272,8 @@ func TestTableNoProviders(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -287,7 +299,8 @@ func TestTableNoRequirements(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -313,7 +326,8 @@ func TestTableNoResources(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -339,7 +353,8 @@ func TestTableOnlyHeader(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -365,7 +380,8 @@ func TestTableOnlyInputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -391,7 +407,8 @@ func TestTableOnlyModulecalls(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -417,7 +434,8 @@ func TestTableOnlyOutputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -443,7 +461,8 @@ func TestTableOnlyProviders(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -469,7 +488,8 @@ func TestTableOnlyRequirements(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -495,7 +515,8 @@ func TestTableOnlyResources(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -515,7 +536,8 @@ func TestTableEscapeCharacters(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -535,7 +557,8 @@ func TestTableIndentationBelowAllowed(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -555,7 +578,8 @@ func TestTableIndentationAboveAllowed(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -575,7 +599,8 @@ func TestTableIndentationOfFour(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -601,7 +626,36 @@ func TestTableOutputValues(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableValueFormatHCL(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		ShowSensitivity: true,
+		ValueFormat:     "hcl",
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-ValueFormatHCL")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		OutputValues:     true,
+		OutputValuesPath: "output_values.json",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -651,7 +705,8 @@ func TestTableHeaderFromFile(t *testing.T) {
 			module, err := testutil.GetModule(options)
 			assert.Nil(err)
 
-			printer := NewMarkdownTable(settings)
+			printer, err := NewMarkdownTable(settings)
+			assert.Nil(err)
 			actual, err := printer.Print(module, settings)
 
 			assert.Nil(err)
@@ -679,7 +734,170 @@ func TestTableOutputValuesNoSensitivity(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableUsage(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-Usage")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		ShowUsage: true,
+		Usage: &terraform.Usage{
+			Source:   "terraform-docs/example/aws",
+			Version:  "1.0.0",
+			Optional: true,
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableDiagram(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowDiagram: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-Diagram")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableDataFlow(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowDataFlow: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-DataFlow")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().WithOverwrite(&terraform.Options{
+		DataFlow: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableDataFlowUnknown(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowDataFlow: true,
+	}).Build()
+
+	module, err := testutil.GetModule(terraform.NewOptions())
+	assert.Nil(err)
+	module.DataFlow = nil // e.g. module with '.tf.json' files
+
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Contains(actual, "## Data flow\n\nData flow is unknown, as `.tf.json` files are not parsed.")
+	assert.NotContains(actual, "| Input | Resources | Outputs |")
+}
+
+func TestTableOutputSources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowOutputSources: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-OutputSources")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().WithOverwrite(&terraform.Options{
+		OutputSources: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableOutputSourcesUnknown(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowOutputSources: true,
+	}).Build()
+
+	// sources of outputs are unknown, e.g. module with '.tf.json' files
+	module, err := testutil.GetModule(terraform.NewOptions())
+	assert.Nil(err)
+
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Contains(actual, "| Name | Description |\n|------|-------------|\n")
+	assert.NotContains(actual, "Derived from")
+}
+
+func TestTableInputReferences(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowInputReferences: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-InputReferences")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().WithOverwrite(&terraform.Options{
+		InputReferences: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -705,9 +923,35 @@ func TestTableEmpty(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewMarkdownTable(settings)
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
 	assert.Equal("", actual)
 }
+
+func TestTableTemplateDir(t *testing.T) {
+	assert := assert.New(t)
+
+	templates, err := template.LoadDir(filepath.Join("testdata", "templates", "markdown"))
+	assert.Nil(err)
+
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		Templates: templates,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-TemplateDir")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewMarkdownTable(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
@@ -73,7 +73,7 @@ const (
 	{{- if .Settings.ShowInputs -}}
 		{{- with .Module.Inputs }}
 			{{- range . }}
-				{{- printf "input.%s" .Name | colorize "\033[36m" }} ({{ default "required" .GetValue }})
+				{{- printf "input.%s" .Name | colorize "\033[36m" }} ({{ default "required" (valueOf .) }})
 				{{ tostring .Description | trimSuffix "\n" | default "n/a" | colorize "\033[90m" }}
 				{{- printf "\n\n" -}}
 			{{ end -}}
@@ -89,7 +89,7 @@ const (
 				{{- printf "output.%s" .Name | colorize "\033[36m" }}
 				{{- if $.Settings.OutputValues -}}
 					{{- printf " " -}}
-					({{ ternary .Sensitive "<sensitive>" .GetValue }})
+					({{ ternary .Sensitive "<sensitive>" (valueOf .) }})
 				{{- end }}
 				{{ tostring .Description | trimSuffix "\n" | default "n/a" | colorize "\033[90m" }}
 				{{- printf "\n\n" -}}
@@ -126,8 +126,17 @@ type Pretty struct {
 }
 
 // NewPretty returns new instance of Pretty.
-func NewPretty(settings *print.Settings) print.Engine {
-	tt := template.New(settings, &template.Item{
+func NewPretty(settings *print.Settings) (print.Engine, error) {
+	tt, err := template.New(settings, gotemplate.FuncMap{
+		"colorize": func(c string, s string) string {
+			r := "\033[0m"
+			if !settings.ShowColor {
+				c = ""
+				r = ""
+			}
+			return fmt.Sprintf("%s%s%s", c, s, r)
+		},
+	}, &template.Item{
 		Name: "pretty",
 		Text: prettyTpl,
 	}, &template.Item{
@@ -152,19 +161,12 @@ func NewPretty(settings *print.Settings) print.Engine {
 		Name: "modulecalls",
 		Text: prettyModulecallsTpl,
 	})
-	tt.CustomFunc(gotemplate.FuncMap{
-		"colorize": func(c string, s string) string {
-			r := "\033[0m"
-			if !settings.ShowColor {
-				c = ""
-				r = ""
-			}
-			return fmt.Sprintf("%s%s%s", c, s, r)
-		},
-	})
+	if err != nil {
+		return nil, err
+	}
 	return &Pretty{
 		template: tt,
-	}
+	}, nil
 }
 
 // Print a Terraform module document.
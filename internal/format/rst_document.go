@@ -0,0 +1,281 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	gotemplate "text/template"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/template"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+)
+
+const (
+	rstDocumentHeaderTpl = `
+	{{- if .Settings.ShowHeader -}}
+		{{- with .Module.Header -}}
+			{{ . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
+	rstDocumentUsageTpl = `
+	{{- with .Module.Usage -}}
+		{{ heading 0 "Usage" }}
+
+		{{ usage . }}
+	{{ end -}}
+	`
+
+	rstDocumentResourcesTpl = `
+	{{- if .Settings.ShowResources -}}
+		{{ heading 0 "Resources" }}
+		{{ if not .Module.Resources }}
+			No resources.
+		{{ else }}
+			The following resources are used by this module:
+			{{ range .Module.Resources }}
+				{{ if eq (len .URL) 0 -}}
+					- {{ literal .FullType }}
+				{{- else -}}
+					- {{ hyperlink .FullType .URL }}
+				{{- end }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstDocumentRequirementsTpl = `
+	{{- if .Settings.ShowRequirements -}}
+		{{ heading 0 "Requirements" }}
+		{{ if not .Module.Requirements }}
+			No requirements.
+		{{ else }}
+			The following requirements are needed by this module:
+			{{ range .Module.Requirements }}
+				- {{ ternary (tostring .Version) (printf "%s (%s)" .Name .Version) .Name | sanitizeRST }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstDocumentProvidersTpl = `
+	{{- if .Settings.ShowProviders -}}
+		{{ heading 0 "Providers" }}
+		{{ if not .Module.Providers }}
+			No providers.
+		{{ else }}
+			The following providers are used by this module:
+			{{ range .Module.Providers }}
+				- {{ ternary (tostring .Version) (printf "%s (%s)" .FullName .Version) .FullName | sanitizeRST }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstDocumentInputsTpl = `
+	{{- if .Settings.ShowInputs -}}
+		{{- if .Settings.ShowRequired -}}
+			{{ heading 0 "Required Inputs" }}
+			{{ if not .Module.RequiredInputs }}
+				No required inputs.
+			{{ else }}
+				The following input variables are required:
+				{{- range .Module.RequiredInputs }}
+					{{ template "input" . }}
+				{{- end }}
+			{{- end }}
+			{{ heading 0 "Optional Inputs" }}
+			{{ if not .Module.OptionalInputs }}
+				No optional inputs.
+			{{ else }}
+				The following input variables are optional (have default values):
+				{{- range .Module.OptionalInputs }}
+					{{ template "input" . }}
+				{{- end }}
+			{{ end }}
+		{{ else -}}
+			{{ heading 0 "Inputs" }}
+			{{ if not .Module.Inputs }}
+				No inputs.
+			{{ else }}
+				The following input variables are supported:
+				{{- range .Module.Inputs }}
+					{{ template "input" . }}
+				{{- end }}
+			{{ end }}
+		{{- end }}
+	{{ end -}}
+	`
+
+	rstDocumentInputTpl = `
+	{{ printf "\n" }}
+	{{ heading 1 (sanitizeRST .Name) }}
+
+	Description: {{ tostring .Description | sanitizeRST }}
+
+	Type: {{ tostring .Type | type }}
+
+	{{ if or .HasDefault (not isRequired) }}
+		Default: {{ default "n/a" (valueOf .) | value }}
+	{{- end }}
+	`
+
+	rstDocumentOutputsTpl = `
+	{{- if .Settings.ShowOutputs -}}
+		{{ heading 0 "Outputs" }}
+		{{ if not .Module.Outputs }}
+			No outputs.
+		{{ else }}
+			The following outputs are exported:
+			{{- range .Module.Outputs }}
+
+				{{ heading 1 (sanitizeRST .Name) }}
+
+				Description: {{ tostring .Description | sanitizeRST }}
+
+				{{ if $.Settings.OutputValues }}
+					{{- $sensitive := ternary .Sensitive "<sensitive>" (valueOf .) -}}
+					Value: {{ value $sensitive }}
+
+					{{ if $.Settings.ShowSensitivity -}}
+						Sensitive: {{ ternary (.Sensitive) "yes" "no" }}
+					{{- end }}
+				{{ end }}
+			{{ end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstDocumentModulecallsTpl = `
+	{{- if .Settings.ShowModuleCalls -}}
+		{{ heading 0 "Modules" }}
+		{{ if not .Module.ModuleCalls }}
+			No modules.
+		{{ else }}
+			The following modules are called:
+			{{- range .Module.ModuleCalls }}
+
+				{{ heading 1 (sanitizeRST .Name) }}
+
+				Source: {{ literal .Source }}
+
+				Version: {{ sanitizeRST .Version }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstDocumentTpl = `
+	{{- template "header" . -}}
+	{{- template "usage" . -}}
+	{{- template "requirements" . -}}
+	{{- template "providers" . -}}
+	{{- template "modulecalls" . -}}
+	{{- template "resources" . -}}
+	{{- template "inputs" . -}}
+	{{- template "outputs" . -}}
+	`
+)
+
+// RSTDocument represents reStructuredText Document format.
+type RSTDocument struct {
+	template *template.Template
+}
+
+// NewRSTDocument returns new instance of RSTDocument.
+func NewRSTDocument(settings *print.Settings) (print.Engine, error) {
+	settings.EscapeCharacters = false
+	tt, err := template.New(settings, gotemplate.FuncMap{
+		"type": func(t string) string {
+			result, extraline := printRSTCodeBlock(t, "hcl")
+			if extraline {
+				result = "\n\n" + result
+			}
+			return result
+		},
+		"value": func(v string) string {
+			if v == "n/a" {
+				return v
+			}
+			result, extraline := printRSTCodeBlock(v, valueLanguage(settings))
+			if extraline {
+				result = "\n\n" + result
+			}
+			return result
+		},
+		"isRequired": func() bool {
+			return settings.ShowRequired
+		},
+		"heading": func(extra int, title string) string {
+			return rstHeading(extra, title, settings)
+		},
+		"literal":   rstLiteral,
+		"hyperlink": rstHyperlink,
+		"usage": func(u string) string {
+			usage, _ := printRSTCodeBlock(u, "hcl")
+			return usage
+		},
+	}, &template.Item{
+		Name: "document",
+		Text: rstDocumentTpl,
+	}, &template.Item{
+		Name: "header",
+		Text: rstDocumentHeaderTpl,
+	}, &template.Item{
+		Name: "usage",
+		Text: rstDocumentUsageTpl,
+	}, &template.Item{
+		Name: "requirements",
+		Text: rstDocumentRequirementsTpl,
+	}, &template.Item{
+		Name: "providers",
+		Text: rstDocumentProvidersTpl,
+	}, &template.Item{
+		Name: "resources",
+		Text: rstDocumentResourcesTpl,
+	}, &template.Item{
+		Name: "inputs",
+		Text: rstDocumentInputsTpl,
+	}, &template.Item{
+		Name: "input",
+		Text: rstDocumentInputTpl,
+	}, &template.Item{
+		Name: "outputs",
+		Text: rstDocumentOutputsTpl,
+	}, &template.Item{
+		Name: "modulecalls",
+		Text: rstDocumentModulecallsTpl,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &RSTDocument{
+		template: tt,
+	}, nil
+}
+
+// Print a Terraform module as reStructuredText document.
+func (d *RSTDocument) Print(module *terraform.Module, settings *print.Settings) (string, error) {
+	rendered, err := d.template.Render(module)
+	if err != nil {
+		return "", err
+	}
+	return sanitize(rendered), nil
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"rst document": NewRSTDocument,
+		"rst doc":      NewRSTDocument,
+	})
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"fmt"
+	gotemplate "text/template"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/template"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+)
+
+const (
+	rstTableHeaderTpl = `
+	{{- if .Settings.ShowHeader -}}
+		{{- with .Module.Header -}}
+			{{ . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+	rstTableUsageTpl = `
+	{{- with .Module.Usage -}}
+		{{ heading 0 "Usage" }}
+
+		{{ usage . }}
+	{{ end -}}
+	`
+	rstTableResourcesTpl = `
+	{{- if .Settings.ShowResources -}}
+		{{ heading 0 "Resources" }}
+		{{ if not .Module.Resources }}
+			No resources.
+		{{ else }}
+			{{ listTable }}
+
+			{{ row "Name" }}
+			{{- range .Module.Resources }}
+				{{ if eq (len .URL) 0 -}}
+					{{ row (literal .FullType) }}
+				{{- else -}}
+					{{ row (hyperlink .FullType .URL) }}
+				{{- end }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstTableRequirementsTpl = `
+	{{- if .Settings.ShowRequirements -}}
+		{{ heading 0 "Requirements" }}
+		{{ if not .Module.Requirements }}
+			No requirements.
+		{{ else }}
+			{{ listTable }}
+
+			{{ row "Name" "Version" }}
+			{{- range .Module.Requirements }}
+				{{ row (sanitizeRST .Name) (tostring .Version | sanitizeRST) }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstTableProvidersTpl = `
+	{{- if .Settings.ShowProviders -}}
+		{{ heading 0 "Providers" }}
+		{{ if not .Module.Providers }}
+			No providers.
+		{{ else }}
+			{{ listTable }}
+
+			{{ row "Name" "Version" }}
+			{{- range .Module.Providers }}
+				{{ row (sanitizeRST .FullName) (tostring .Version | sanitizeRST) }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstTableInputsTpl = `
+	{{- if .Settings.ShowInputs -}}
+		{{ heading 0 "Inputs" }}
+		{{ if not .Module.Inputs }}
+			No inputs.
+		{{ else }}
+			{{ listTable }}
+
+			{{ if .Settings.ShowRequired -}}
+				{{ row "Name" "Description" "Type" "Default" "Required" }}
+			{{- else -}}
+				{{ row "Name" "Description" "Type" "Default" }}
+			{{- end }}
+			{{- range .Module.Inputs }}
+				{{ if $.Settings.ShowRequired -}}
+					{{ row (sanitizeRST .Name) (tostring .Description | sanitizeRST) (tostring .Type | type) (value (valueOf .)) (ternary .Required "yes" "no") }}
+				{{- else -}}
+					{{ row (sanitizeRST .Name) (tostring .Description | sanitizeRST) (tostring .Type | type) (value (valueOf .)) }}
+				{{- end }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstTableOutputsTpl = `
+	{{- if .Settings.ShowOutputs -}}
+		{{ heading 0 "Outputs" }}
+		{{ if not .Module.Outputs }}
+			No outputs.
+		{{ else }}
+			{{ listTable }}
+
+			{{ if not .Settings.OutputValues -}}
+				{{ row "Name" "Description" }}
+			{{- else if .Settings.ShowSensitivity -}}
+				{{ row "Name" "Description" "Value" "Sensitive" }}
+			{{- else -}}
+				{{ row "Name" "Description" "Value" }}
+			{{- end }}
+			{{- range .Module.Outputs }}
+				{{- $value := ternary .Sensitive "<sensitive>" (valueOf .) }}
+				{{ if not $.Settings.OutputValues -}}
+					{{ row (sanitizeRST .Name) (tostring .Description | sanitizeRST) }}
+				{{- else if $.Settings.ShowSensitivity -}}
+					{{ row (sanitizeRST .Name) (tostring .Description | sanitizeRST) (value $value) (ternary .Sensitive "yes" "no") }}
+				{{- else -}}
+					{{ row (sanitizeRST .Name) (tostring .Description | sanitizeRST) (value $value) }}
+				{{- end }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstTableModulecallsTpl = `
+	{{- if .Settings.ShowModuleCalls -}}
+		{{ heading 0 "Modules" }}
+		{{ if not .Module.ModuleCalls }}
+			No modules.
+		{{ else }}
+			{{ listTable }}
+
+			{{ row "Name" "Source" "Version" }}
+			{{- range .Module.ModuleCalls }}
+				{{ row (sanitizeRST .Name) (literal .Source) (sanitizeRST .Version) }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstTableTpl = `
+	{{- template "header" . -}}
+	{{- template "usage" . -}}
+	{{- template "requirements" . -}}
+	{{- template "providers" . -}}
+	{{- template "modulecalls" . -}}
+	{{- template "resources" . -}}
+	{{- template "inputs" . -}}
+	{{- template "outputs" . -}}
+	`
+)
+
+// RSTTable represents reStructuredText Table format.
+type RSTTable struct {
+	template *template.Template
+}
+
+// NewRSTTable returns new instance of RSTTable.
+func NewRSTTable(settings *print.Settings) (print.Engine, error) {
+	settings.EscapeCharacters = false
+	tt, err := template.New(settings, gotemplate.FuncMap{
+		"type": func(t string) string {
+			result, _ := printRSTCodeBlock(t, "hcl")
+			return result
+		},
+		"value": func(v string) string {
+			if v == "" {
+				return "n/a"
+			}
+			result, _ := printRSTCodeBlock(v, valueLanguage(settings))
+			return result
+		},
+		"heading": func(extra int, title string) string {
+			return rstHeading(extra, title, settings)
+		},
+		"listTable": func() string {
+			return ".. list-table::\n   :header-rows: 1"
+		},
+		"row":       rstRow,
+		"literal":   rstLiteral,
+		"hyperlink": rstHyperlink,
+		"usage": func(u string) string {
+			usage, _ := printRSTCodeBlock(u, "hcl")
+			return usage
+		},
+	}, &template.Item{
+		Name: "table",
+		Text: rstTableTpl,
+	}, &template.Item{
+		Name: "header",
+		Text: rstTableHeaderTpl,
+	}, &template.Item{
+		Name: "usage",
+		Text: rstTableUsageTpl,
+	}, &template.Item{
+		Name: "resources",
+		Text: rstTableResourcesTpl,
+	}, &template.Item{
+		Name: "requirements",
+		Text: rstTableRequirementsTpl,
+	}, &template.Item{
+		Name: "providers",
+		Text: rstTableProvidersTpl,
+	}, &template.Item{
+		Name: "inputs",
+		Text: rstTableInputsTpl,
+	}, &template.Item{
+		Name: "outputs",
+		Text: rstTableOutputsTpl,
+	}, &template.Item{
+		Name: "modulecalls",
+		Text: rstTableModulecallsTpl,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &RSTTable{
+		template: tt,
+	}, nil
+}
+
+// Print a Terraform module as reStructuredText tables.
+func (t *RSTTable) Print(module *terraform.Module, settings *print.Settings) (string, error) {
+	rendered, err := t.template.Render(module)
+	if err != nil {
+		return "", err
+	}
+	return sanitize(rendered), nil
+}
+
+// rstLiteral returns 's' as inline literal, or 'n/a' if it's empty.
+func rstLiteral(s string) string {
+	if s == "" {
+		return "n/a"
+	}
+	return fmt.Sprintf("``%s``", s)
+}
+
+// rstHyperlink returns an anonymous hyperlink to 'url' with 'text', which
+// doesn't create a target and therefore can be repeated in the document.
+func rstHyperlink(text string, url string) string {
+	return fmt.Sprintf("`%s <%s>`__", text, url)
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"rst":       NewRSTTable,
+		"rst table": NewRSTTable,
+		"rst tbl":   NewRSTTable,
+	})
+}
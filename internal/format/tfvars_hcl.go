@@ -19,13 +19,28 @@ import (
 	"github.com/terraform-docs/terraform-docs/internal/print"
 	"github.com/terraform-docs/terraform-docs/internal/template"
 	"github.com/terraform-docs/terraform-docs/internal/terraform"
+	"github.com/terraform-docs/terraform-docs/internal/types"
 )
 
 const (
 	tfvarsHCLTpl = `
 	{{- if .Module.Inputs -}}
 		{{- range $i, $k := .Module.Inputs -}}
-			{{ align $k.Name $i }} = {{ value $k.GetValue }}
+			{{ align $k.Name $i }} = {{ value (valueOf $k) }}
+		{{ end -}}
+	{{- end -}}
+	`
+	tfvarsHCLDescriptionTpl = `
+	{{- if .Module.Inputs -}}
+		{{- range .Module.RequiredInputs -}}
+			{{ comment . }}
+			{{ .Name }} = {{ placeholder . }}
+
+		{{ end -}}
+		{{- range .Module.OptionalInputs -}}
+			{{ comment . }}
+			{{ .Name }} = {{ hcl .Default }}
+
 		{{ end -}}
 	{{- end -}}
 	`
@@ -39,12 +54,12 @@ type TfvarsHCL struct {
 var padding []int
 
 // NewTfvarsHCL returns new instance of TfvarsHCL.
-func NewTfvarsHCL(settings *print.Settings) print.Engine {
-	tt := template.New(settings, &template.Item{
-		Name: "tfvars",
-		Text: tfvarsHCLTpl,
-	})
-	tt.CustomFunc(gotemplate.FuncMap{
+func NewTfvarsHCL(settings *print.Settings) (print.Engine, error) {
+	text := tfvarsHCLTpl
+	if settings.TfvarsDescription {
+		text = tfvarsHCLDescriptionTpl
+	}
+	tt, err := template.New(settings, gotemplate.FuncMap{
 		"align": func(s string, i int) string {
 			return fmt.Sprintf("%-*s", padding[i], s)
 		},
@@ -54,10 +69,36 @@ func NewTfvarsHCL(settings *print.Settings) print.Engine {
 			}
 			return s
 		},
+		"comment": func(i *terraform.Input) string {
+			var b strings.Builder
+			if description := strings.TrimSpace(string(i.Description)); description != "" {
+				for _, line := range strings.Split(description, "\n") {
+					b.WriteString(strings.TrimRight("# "+line, " \t\r") + "\n")
+				}
+			}
+			marker := "optional"
+			if i.Required {
+				marker = "required"
+			}
+			b.WriteString(fmt.Sprintf("# type: %s (%s)", i.TypeConstraint(), marker))
+			return b.String()
+		},
+		"placeholder": func(i *terraform.Input) string {
+			return types.HCL(i.TypeConstraint().Placeholder())
+		},
+		"hcl": func(v types.Value) string {
+			return types.HCL(v)
+		},
+	}, &template.Item{
+		Name: "tfvars",
+		Text: text,
 	})
+	if err != nil {
+		return nil, err
+	}
 	return &TfvarsHCL{
 		template: tt,
-	}
+	}, nil
 }
 
 // Print a Terraform module as Terraform tfvars HCL.
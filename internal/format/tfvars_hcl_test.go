@@ -31,7 +31,29 @@ func TestTfvarsHcl(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewTfvarsHCL(settings)
+	printer, err := NewTfvarsHCL(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTfvarsHclValueFormatHCL(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ValueFormat: "hcl",
+	}).Build()
+
+	expected, err := testutil.GetExpected("tfvars", "hcl-ValueFormatHCL")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewTfvarsHCL(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -57,7 +79,8 @@ func TestTfvarsHclSortByName(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewTfvarsHCL(settings)
+	printer, err := NewTfvarsHCL(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -85,7 +108,8 @@ func TestTfvarsHclSortByRequired(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewTfvarsHCL(settings)
+	printer, err := NewTfvarsHCL(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -111,7 +135,8 @@ func TestTfvarsHclSortByType(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewTfvarsHCL(settings)
+	printer, err := NewTfvarsHCL(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -135,7 +160,8 @@ func TestTfvarsHclNoInputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewTfvarsHCL(settings)
+	printer, err := NewTfvarsHCL(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -155,7 +181,29 @@ func TestTfvarsHclEscapeCharacters(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewTfvarsHCL(settings)
+	printer, err := NewTfvarsHCL(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTfvarsHclTfvarsDescription(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		TfvarsDescription: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("tfvars", "hcl-TfvarsDescription")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewTfvarsHCL(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -25,8 +25,8 @@ import (
 type TfvarsJSON struct{}
 
 // NewTfvarsJSON returns new instance of TfvarsJSON.
-func NewTfvarsJSON(settings *print.Settings) print.Engine {
-	return &TfvarsJSON{}
+func NewTfvarsJSON(settings *print.Settings) (print.Engine, error) {
+	return &TfvarsJSON{}, nil
 }
 
 // Print a Terraform module as Terraform tfvars JSON.
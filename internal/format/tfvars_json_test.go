@@ -31,7 +31,8 @@ func TestTfvarsJson(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewTfvarsJSON(settings)
+	printer, err := NewTfvarsJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -57,7 +58,8 @@ func TestTfvarsJsonSortByName(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewTfvarsJSON(settings)
+	printer, err := NewTfvarsJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -85,7 +87,8 @@ func TestTfvarsJsonSortByRequired(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewTfvarsJSON(settings)
+	printer, err := NewTfvarsJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -111,7 +114,8 @@ func TestTfvarsJsonSortByType(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewTfvarsJSON(settings)
+	printer, err := NewTfvarsJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -135,7 +139,8 @@ func TestTfvarsJsonNoInputs(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewTfvarsJSON(settings)
+	printer, err := NewTfvarsJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
@@ -155,7 +160,8 @@ func TestTfvarsJsonEscapeCharacters(t *testing.T) {
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
-	printer := NewTfvarsJSON(settings)
+	printer, err := NewTfvarsJSON(settings)
+	assert.Nil(err)
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
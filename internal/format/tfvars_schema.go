@@ -0,0 +1,221 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/orderedmap"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// TfvarsSchema represents JSON Schema format of Terraform tfvars.
+type TfvarsSchema struct{}
+
+// NewTfvarsSchema returns new instance of TfvarsSchema.
+func NewTfvarsSchema(settings *print.Settings) (print.Engine, error) {
+	return &TfvarsSchema{}, nil
+}
+
+// Print a Terraform module inputs as JSON Schema of Terraform tfvars.
+func (s *TfvarsSchema) Print(module *terraform.Module, settings *print.Settings) (string, error) {
+	properties := orderedmap.New()
+	properties.SetEscapeHTML(false)
+	required := make([]string, 0)
+
+	for _, i := range module.Inputs {
+		property := jsonSchemaOf(i.TypeConstraint())
+		if description := strings.TrimSpace(string(i.Description)); description != "" {
+			property.Set("description", description)
+		}
+		if i.Required {
+			required = append(required, i.Name)
+		} else if value, ok := jsonSchemaDefault(i.TypeConstraint(), i.Default.Raw()); ok {
+			if t, ok := property.Get("type"); ok && value == nil {
+				property.Set("type", []interface{}{t, "null"})
+			}
+			property.Set("default", value)
+		}
+		properties.Set(i.Name, property)
+	}
+
+	document := orderedmap.New()
+	document.SetEscapeHTML(false)
+	document.Set("$schema", "http://json-schema.org/draft-07/schema#")
+	document.Set("type", "object")
+	document.Set("properties", properties)
+	document.Set("required", required)
+	document.Set("additionalProperties", false)
+
+	buffer := new(bytes.Buffer)
+
+	encoder := json.NewEncoder(buffer)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+
+	err := encoder.Encode(document)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+// jsonSchemaOf converts Terraform type constraint to its JSON Schema equivalent.
+func jsonSchemaOf(c *types.Constraint) *orderedmap.OrderedMap {
+	schema := orderedmap.New()
+	schema.SetEscapeHTML(false)
+	switch c.Kind {
+	case types.KindBool:
+		schema.Set("type", "boolean")
+	case types.KindNumber:
+		schema.Set("type", "number")
+	case types.KindString:
+		schema.Set("type", "string")
+	case types.KindList, types.KindSet:
+		schema.Set("type", "array")
+		schema.Set("items", jsonSchemaOf(c.Element))
+		if c.Kind == types.KindSet {
+			schema.Set("uniqueItems", true)
+		}
+	case types.KindMap:
+		schema.Set("type", "object")
+		schema.Set("additionalProperties", jsonSchemaOf(c.Element))
+	case types.KindTuple:
+		items := make([]*orderedmap.OrderedMap, 0, len(c.Elements))
+		for _, e := range c.Elements {
+			items = append(items, jsonSchemaOf(e))
+		}
+		schema.Set("type", "array")
+		schema.Set("items", items)
+		schema.Set("minItems", len(items))
+		schema.Set("maxItems", len(items))
+	case types.KindObject:
+		properties := orderedmap.New()
+		properties.SetEscapeHTML(false)
+		required := make([]string, 0)
+		for _, a := range c.Attributes {
+			properties.Set(a.Name, jsonSchemaOf(a.Type))
+			if !a.Optional {
+				required = append(required, a.Name)
+			}
+		}
+		schema.Set("type", "object")
+		schema.Set("properties", properties)
+		schema.Set("required", required)
+	}
+	return schema
+}
+
+// jsonSchemaDefault returns default 'value' of an input converted to the type of
+// constraint 'c', the same way Terraform converts it (e.g. "19" to 19 for number),
+// or false if it can't be converted. A null default is returned as is, and the
+// type of the input must allow null for it.
+func jsonSchemaDefault(c *types.Constraint, value interface{}) (interface{}, bool) {
+	if value == nil || c.Kind == types.KindAny {
+		return value, true
+	}
+	switch c.Kind {
+	case types.KindBool:
+		switch v := value.(type) {
+		case bool:
+			return v, true
+		case string:
+			if v == "true" || v == "false" {
+				return v == "true", true
+			}
+		}
+	case types.KindNumber:
+		switch v := value.(type) {
+		case float64:
+			return v, true
+		case int:
+			return v, true
+		case string:
+			if n, err := strconv.ParseFloat(v, 64); err == nil {
+				return n, true
+			}
+		}
+	case types.KindString:
+		switch v := value.(type) {
+		case string:
+			return v, true
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), true
+		case int:
+			return strconv.Itoa(v), true
+		case bool:
+			return strconv.FormatBool(v), true
+		}
+	case types.KindList, types.KindSet, types.KindTuple:
+		list, ok := value.([]interface{})
+		if !ok || (c.Kind == types.KindTuple && len(list) != len(c.Elements)) {
+			return nil, false
+		}
+		items := make([]interface{}, 0, len(list))
+		for n, item := range list {
+			element := c.Element
+			if c.Kind == types.KindTuple {
+				element = c.Elements[n]
+			}
+			converted, ok := jsonSchemaDefault(element, item)
+			if !ok || converted == nil {
+				return nil, false
+			}
+			items = append(items, converted)
+		}
+		return items, true
+	case types.KindMap, types.KindObject:
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		converted := make(map[string]interface{}, len(m))
+		if c.Kind == types.KindMap {
+			for key, item := range m {
+				v, ok := jsonSchemaDefault(c.Element, item)
+				if !ok || v == nil {
+					return nil, false
+				}
+				converted[key] = v
+			}
+			return converted, true
+		}
+		for _, a := range c.Attributes {
+			item, found := m[a.Name]
+			if !found {
+				if !a.Optional {
+					return nil, false
+				}
+				continue
+			}
+			v, ok := jsonSchemaDefault(a.Type, item)
+			if !ok || v == nil {
+				return nil, false
+			}
+			converted[a.Name] = v
+		}
+		return converted, true
+	}
+	return nil, false
+}
+
+func init() {
+	register(map[string]initializerFn{
+		"tfvars schema": NewTfvarsSchema,
+	})
+}
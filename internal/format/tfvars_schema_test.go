@@ -0,0 +1,270 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+func TestTfvarsSchema(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	expected, err := testutil.GetExpected("tfvars", "schema")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewTfvarsSchema(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTfvarsSchemaSortByName(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SortByName: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("tfvars", "schema-SortByName")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		SortBy: &terraform.SortBy{
+			Name: true,
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewTfvarsSchema(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTfvarsSchemaSortByRequired(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SortByName:     true,
+		SortByRequired: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("tfvars", "schema-SortByRequired")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		SortBy: &terraform.SortBy{
+			Name:     true,
+			Required: true,
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewTfvarsSchema(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTfvarsSchemaSortByType(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SortByType: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("tfvars", "schema-SortByType")
+	assert.Nil(err)
+
+	options, err := terraform.NewOptions().With(&terraform.Options{
+		SortBy: &terraform.SortBy{
+			Type: true,
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewTfvarsSchema(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTfvarsSchemaNoInputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowHeader:       true,
+		ShowInputs:       false,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("tfvars", "schema-NoInputs")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewTfvarsSchema(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTfvarsSchemaEscapeCharacters(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		EscapeCharacters: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("tfvars", "schema-EscapeCharacters")
+	assert.Nil(err)
+
+	options := terraform.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewTfvarsSchema(settings)
+	assert.Nil(err)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTfvarsSchemaDefault(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		value      interface{}
+		expected   interface{}
+		ok         bool
+	}{
+		{
+			name:       "default of number from string",
+			constraint: "number",
+			value:      "19",
+			expected:   float64(19),
+			ok:         true,
+		},
+		{
+			name:       "default of number from invalid string",
+			constraint: "number",
+			value:      "foo",
+			expected:   nil,
+			ok:         false,
+		},
+		{
+			name:       "default of string from number",
+			constraint: "string",
+			value:      float64(1.5),
+			expected:   "1.5",
+			ok:         true,
+		},
+		{
+			name:       "default of bool from string",
+			constraint: "bool",
+			value:      "true",
+			expected:   true,
+			ok:         true,
+		},
+		{
+			name:       "default of null",
+			constraint: "string",
+			value:      nil,
+			expected:   nil,
+			ok:         true,
+		},
+		{
+			name:       "default of list of numbers",
+			constraint: "list(number)",
+			value:      []interface{}{"1", float64(2)},
+			expected:   []interface{}{float64(1), float64(2)},
+			ok:         true,
+		},
+		{
+			name:       "default of list with null item",
+			constraint: "list(number)",
+			value:      []interface{}{nil},
+			expected:   nil,
+			ok:         false,
+		},
+		{
+			name:       "default of tuple with wrong length",
+			constraint: "tuple([string, number])",
+			value:      []interface{}{"foo"},
+			expected:   nil,
+			ok:         false,
+		},
+		{
+			name:       "default of object without required attribute",
+			constraint: "object({name = string, size = number})",
+			value:      map[string]interface{}{"name": "foo"},
+			expected:   nil,
+			ok:         false,
+		},
+		{
+			name:       "default of map of strings",
+			constraint: "map(string)",
+			value:      map[string]interface{}{"foo": true},
+			expected:   map[string]interface{}{"foo": "true"},
+			ok:         true,
+		},
+		{
+			name:       "default of any",
+			constraint: "any",
+			value:      "foo",
+			expected:   "foo",
+			ok:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			constraint, err := types.ParseConstraint(tt.constraint)
+			assert.Nil(err)
+
+			actual, ok := jsonSchemaDefault(constraint, tt.value)
+			assert.Equal(tt.ok, ok)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
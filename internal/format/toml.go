@@ -17,6 +17,7 @@ import (
 	"github.com/BurntSushi/toml"
 
 	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/schema"
 	"github.com/terraform-docs/terraform-docs/internal/terraform"
 )
 
@@ -24,8 +25,8 @@ import (
 type TOML struct{}
 
 // NewTOML returns new instance of TOML.
-func NewTOML(settings *print.Settings) print.Engine {
-	return &TOML{}
+func NewTOML(settings *print.Settings) (print.Engine, error) {
+	return &TOML{}, nil
 }
 
 // Print a Terraform module as toml.
@@ -61,6 +62,11 @@ func (t *TOML) Print(module *terraform.Module, settings *print.Settings) (string
 	if settings.ShowResources {
 		copy.Resources = module.Resources
 	}
+	if settings.ShowDataFlow {
+		copy.DataFlow = module.DataFlow
+	}
+
+	copy = *schema.Restrict(&copy, settings.SchemaVersion)
 
 	buffer := new(bytes.Buffer)
 	encoder := toml.NewEncoder(buffer)
@@ -14,6 +14,9 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
 )
 
 // sanitize cleans a Markdown document to soothe linters.
@@ -63,3 +66,87 @@ func printFencedAsciidocCodeBlock(code string, language string) (string, bool) {
 	}
 	return fmt.Sprintf("`%s`", code), false
 }
+
+// printRSTCodeBlock prints codes in reStructuredText, it automatically detects
+// if the input 'code' contains '\n' it will use 'code-block' directive indented
+// by three spaces and returns true, otherwise it wraps the 'code' inside inline
+// literal and returns false.
+func printRSTCodeBlock(code string, language string) (string, bool) {
+	if strings.Contains(code, "\n") {
+		lines := strings.Split(code, "\n")
+		for i, line := range lines {
+			if line != "" {
+				lines[i] = "   " + line
+			}
+		}
+		directive := strings.TrimSpace(".. code-block:: " + language)
+		return fmt.Sprintf("%s\n\n%s\n", directive, strings.Join(lines, "\n")), true
+	}
+	return fmt.Sprintf("``%s``", code), false
+}
+
+// rstHeadingChars are the characters used to underline reStructuredText
+// section titles, from top to bottom level.
+var rstHeadingChars = []string{"=", "-", "~", "^", "\"", "'"}
+
+// rstHeading returns the reStructuredText section 'title' underlined with the
+// character of base level of provided 'settings.IndentLevel' plus any 'extra'
+// level needed for subsection (e.g. each input in 'Inputs' section.)
+func rstHeading(extra int, title string, settings *print.Settings) string {
+	var level = settings.IndentLevel
+	if level < 1 || level > 5 {
+		level = 2
+	}
+	level += extra
+	if level > len(rstHeadingChars) {
+		level = len(rstHeadingChars)
+	}
+	underline := strings.Repeat(rstHeadingChars[level-1], utf8.RuneCountInString(title))
+	return fmt.Sprintf("%s\n%s", title, underline)
+}
+
+// rstRow returns a row of reStructuredText 'list-table' with 'cells'. Lines
+// of multi-line cells (e.g. code blocks) are indented to belong to the cell.
+func rstRow(cells ...string) string {
+	var b strings.Builder
+	for i, cell := range cells {
+		if i == 0 {
+			b.WriteString("   * - ")
+		} else {
+			b.WriteString("\n     - ")
+		}
+		lines := strings.Split(strings.TrimSpace(cell), "\n")
+		for j, line := range lines {
+			if j > 0 {
+				b.WriteString("\n")
+				if line != "" {
+					b.WriteString("       ")
+				}
+			}
+			b.WriteString(line)
+		}
+	}
+	return b.String()
+}
+
+// valueLanguage returns the language of code blocks of default values of inputs
+// and values of outputs, based on the provided value format in 'settings'.
+func valueLanguage(settings *print.Settings) string {
+	if settings.ValueFormat == "hcl" {
+		return "hcl"
+	}
+	return "json"
+}
+
+// printAddresses returns 'addresses' as comma separated inline codes, or "n/a"
+// if there is none.
+func printAddresses(addresses []string) string {
+	if len(addresses) == 0 {
+		return "n/a"
+	}
+	codes := make([]string, 0, len(addresses))
+	for _, a := range addresses {
+		codes = append(codes, "`"+a+"`")
+	}
+	return strings.Join(codes, ", ")
+}
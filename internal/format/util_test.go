@@ -129,3 +129,83 @@ func TestFenceCodeBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestRSTCodeBlock(t *testing.T) {
+	tests := []struct {
+		name      string
+		code      string
+		language  string
+		expected  string
+		extraline bool
+	}{
+		{
+			name:      "single line",
+			code:      "foo",
+			language:  "json",
+			expected:  "``foo``",
+			extraline: false,
+		},
+		{
+			name:      "single line",
+			code:      "fuzz_buzz",
+			language:  "",
+			expected:  "``fuzz_buzz``",
+			extraline: false,
+		},
+		{
+			name:      "multi lines",
+			code:      "[\n  \"foo\",\n  \"bar\"\n]",
+			language:  "json",
+			expected:  ".. code-block:: json\n\n   [\n     \"foo\",\n     \"bar\"\n   ]\n",
+			extraline: true,
+		},
+		{
+			name:      "multi lines",
+			code:      "variable \"foo\" {\n\n  default = true\n}",
+			language:  "",
+			expected:  ".. code-block::\n\n   variable \"foo\" {\n\n     default = true\n   }\n",
+			extraline: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual, extraline := printRSTCodeBlock(tt.code, tt.language)
+
+			assert.Equal(tt.expected, actual)
+			assert.Equal(tt.extraline, extraline)
+		})
+	}
+}
+
+func TestPrintAddresses(t *testing.T) {
+	tests := []struct {
+		name      string
+		addresses []string
+		expected  string
+	}{
+		{
+			name:      "no address",
+			addresses: []string{},
+			expected:  "n/a",
+		},
+		{
+			name:      "single address",
+			addresses: []string{"aws_instance.this"},
+			expected:  "`aws_instance.this`",
+		},
+		{
+			name:      "multiple addresses",
+			addresses: []string{"data.aws_ami.this", "module.vpc"},
+			expected:  "`data.aws_ami.this`, `module.vpc`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := printAddresses(tt.addresses)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"strings"
 
 	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/schema"
 	"github.com/terraform-docs/terraform-docs/internal/terraform"
 )
 
@@ -22,8 +23,8 @@ import (
 type XML struct{}
 
 // NewXML returns new instance of XML.
-func NewXML(settings *print.Settings) print.Engine {
-	return &XML{}
+func NewXML(settings *print.Settings) (print.Engine, error) {
+	return &XML{}, nil
 }
 
 // Print a Terraform module as xml.
@@ -59,6 +60,11 @@ func (x *XML) Print(module *terraform.Module, settings *print.Settings) (string,
 	if settings.ShowResources {
 		copy.Resources = module.Resources
 	}
+	if settings.ShowDataFlow {
+		copy.DataFlow = module.DataFlow
+	}
+
+	copy = schema.Restrict(copy, settings.SchemaVersion)
 
 	out, err := xml.MarshalIndent(copy, "", "  ")
 	if err != nil {
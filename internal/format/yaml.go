@@ -17,6 +17,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/schema"
 	"github.com/terraform-docs/terraform-docs/internal/terraform"
 )
 
@@ -24,8 +25,8 @@ import (
 type YAML struct{}
 
 // NewYAML returns new instance of YAML.
-func NewYAML(settings *print.Settings) print.Engine {
-	return &YAML{}
+func NewYAML(settings *print.Settings) (print.Engine, error) {
+	return &YAML{}, nil
 }
 
 // Print a Terraform module as yaml.
@@ -61,6 +62,11 @@ func (y *YAML) Print(module *terraform.Module, settings *print.Settings) (string
 	if settings.ShowResources {
 		copy.Resources = module.Resources
 	}
+	if settings.ShowDataFlow {
+		copy.DataFlow = module.DataFlow
+	}
+
+	copy = schema.Restrict(copy, settings.SchemaVersion)
 
 	buffer := new(bytes.Buffer)
 
@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package lint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+)
+
+// RuleUnusedVariable is the rule of inputs which are declared but never
+// referenced in the module.
+const RuleUnusedVariable = "unused-variable"
+
+// Finding represents a problem found in a module, at 'Line' of 'Filename',
+// which violates 'Rule'.
+type Finding struct {
+	Filename string
+	Line     int
+	Rule     string
+	Message  string
+}
+
+// String returns finding in 'file:line: message (rule)' format.
+func (f *Finding) String() string {
+	return fmt.Sprintf("%s:%d: %s (%s)", f.Filename, f.Line, f.Message, f.Rule)
+}
+
+// Lint returns the problems found in 'module', sorted by their position. The
+// module must be loaded with references of its inputs, otherwise none of its
+// inputs is known to be unused.
+func Lint(module *terraform.Module) []*Finding {
+	findings := []*Finding{}
+	for _, i := range module.Inputs {
+		if !i.Unused {
+			continue
+		}
+		findings = append(findings, &Finding{
+			Filename: i.Position.Filename,
+			Line:     i.Position.Line,
+			Rule:     RuleUnusedVariable,
+			Message:  fmt.Sprintf("variable '%s' is declared but not used", i.Name),
+		})
+	}
+	sort.Slice(findings, func(i, j int) bool {
+		if findings[i].Filename != findings[j].Filename {
+			return findings[i].Filename < findings[j].Filename
+		}
+		return findings[i].Line < findings[j].Line
+	})
+	return findings
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package lint
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+)
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		references bool
+		expected   []string
+	}{
+		{
+			name:       "lint module with unused variables",
+			path:       "unused-variables",
+			references: true,
+			expected: []string{
+				"testdata/unused-variables/variables.tf:10: variable 'legacy' is declared but not used (unused-variable)",
+				"testdata/unused-variables/variables.tf:15: variable 'validated' is declared but not used (unused-variable)",
+			},
+		},
+		{
+			name:       "lint module without unused variables",
+			path:       "no-unused-variables",
+			references: true,
+			expected:   []string{},
+		},
+		{
+			name:       "lint module with variables referenced in json files",
+			path:       "json-variables",
+			references: true,
+			expected:   []string{},
+		},
+		{
+			name:       "lint module without references",
+			path:       "unused-variables",
+			references: false,
+			expected:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			options, err := terraform.NewOptions().With(&terraform.Options{
+				Path:            filepath.Join("testdata", tt.path),
+				InputReferences: tt.references,
+			})
+			assert.Nil(err)
+
+			module, err := terraform.LoadWithOptions(options)
+			assert.Nil(err)
+
+			actual := []string{}
+			for _, f := range Lint(module) {
+				actual = append(actual, filepath.ToSlash(f.String()))
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
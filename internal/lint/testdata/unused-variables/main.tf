@@ -0,0 +1,13 @@
+provider "aws" {
+  region = var.region
+}
+
+locals {
+  name = "${var.prefix}-instance"
+}
+
+resource "aws_instance" "this" {
+  tags = {
+    Name = local.name
+  }
+}
@@ -0,0 +1,22 @@
+variable "region" {
+  type = string
+}
+
+variable "prefix" {
+  type    = string
+  default = "foo"
+}
+
+variable "legacy" {
+  type    = string
+  default = ""
+}
+
+variable "validated" {
+  type = number
+
+  validation {
+    condition     = var.validated > 0
+    error_message = "The validated must be positive."
+  }
+}
@@ -1,12 +1,9 @@
 package plugin
 
 import (
-	"fmt"
 	"io/ioutil"
 	"os"
-	"os/exec"
 	"path/filepath"
-	"runtime"
 	"strings"
 
 	goplugin "github.com/hashicorp/go-plugin"
@@ -15,90 +12,164 @@ import (
 	pluginsdk "github.com/terraform-docs/plugin-sdk/plugin"
 )
 
-// Discover plugins and registers them. The lookup priority of plugins is as
-// follow:
+// Discover plugins and indexes them. All the following directories are
+// looked up, in the order of precedence:
 //
-// 1. `TFDOCS_PLUGIN_DIR` environment variable (if it's set)
-// 2. Current directory (./.tfdocs.d/plugins)
-// 3. Home directory (~/.tfdocs.d/plugins)
+//  1. `TFDOCS_PLUGIN_DIR` environment variable (if it's set), which can be a
+//     list of directories separated by OS-specific path list separator
+//  2. Current directory (./.tfdocs.d/plugins)
+//  3. Home directory (~/.tfdocs.d/plugins)
 //
 // Files under these directories that satisfy the "tfdocs-format-*" naming
-// convention are treated as plugins.
+// convention are treated as plugins. If plugins with the same name exist in
+// more than one directory, the one with the highest precedence is used and
+// the others are marked as shadowed. Plugins are not started by discovery,
+// they are started on demand by List.Get or List.All.
 func Discover() (*List, error) {
-	if dir := os.Getenv("TFDOCS_PLUGIN_DIR"); dir != "" {
-		return findPlugins(dir)
-	}
-
-	if _, err := os.Stat(localPluginsRoot); !os.IsNotExist(err) {
-		return findPlugins(localPluginsRoot)
-	}
-
-	dir, err := homedir.Expand(homePluginsRoot)
+	dirs, err := pluginDirs()
 	if err != nil {
 		return nil, err
 	}
-
-	return findPlugins(dir)
+	return findPlugins(dirs), nil
 }
 
-// findPlugins finds plugins in a given 'dir' and registers them.
-func findPlugins(dir string) (*List, error) {
-	clients := map[string]*goplugin.Client{}
-	formatters := map[string]*pluginsdk.Client{}
+// pluginDirs returns unique list of directories to look up plugins in, in
+// the order of precedence.
+func pluginDirs() ([]string, error) {
+	candidates := []string{}
+	if env := os.Getenv("TFDOCS_PLUGIN_DIR"); env != "" {
+		candidates = append(candidates, filepath.SplitList(env)...)
+	}
+	candidates = append(candidates, localPluginsRoot)
 
-	files, err := ioutil.ReadDir(dir)
+	home, err := homedir.Expand(homePluginsRoot)
 	if err != nil {
 		return nil, err
 	}
+	candidates = append(candidates, home)
 
-	for _, f := range files {
-		name := strings.Replace(f.Name(), namePrefix, "", -1)
-		path, err := getPluginPath(dir, name)
+	dirs := []string{}
+	seen := map[string]bool{}
+	for _, dir := range candidates {
+		abs, err := filepath.Abs(dir)
 		if err != nil {
-			return nil, err
+			abs = dir
 		}
+		if dir == "" || seen[abs] {
+			continue
+		}
+		seen[abs] = true
+		dirs = append(dirs, dir)
+	}
+	return dirs, nil
+}
 
-		cmd := exec.Command(path)
-
-		client := pluginsdk.NewClient(&pluginsdk.ClientOpts{
-			Cmd: cmd,
-		})
+// findPlugins finds plugins in given 'dirs' and indexes them.
+func findPlugins(dirs []string) *List {
+	list := &List{
+		formatters: map[string]*pluginsdk.Client{},
+		clients:    map[string]*goplugin.Client{},
+		plugins:    []*Plugin{},
+		index:      map[string]*Plugin{},
+		checksums:  map[string]string{},
+		env:        []string{},
+	}
 
-		rpcClient, err := client.Client()
-		if err != nil {
-			return nil, err
+	for _, dir := range dirs {
+		files, err := ioutil.ReadDir(dir)
+		if os.IsNotExist(err) {
+			continue
 		}
-
-		raw, err := rpcClient.Dispense("formatter")
 		if err != nil {
-			return nil, err
+			list.plugins = append(list.plugins, &Plugin{Path: dir, Err: err})
+			continue
 		}
 
-		formatter := raw.(*pluginsdk.Client)
+		for _, f := range files {
+			name, ok := pluginName(f)
+			if !ok {
+				continue
+			}
 
-		if _, ok := clients[name]; ok {
-			return nil, fmt.Errorf("plugin %s is already registered", name)
+			plugin := &Plugin{
+				Name: name,
+				Path: filepath.Join(dir, f.Name()),
+			}
+			list.plugins = append(list.plugins, plugin)
+
+			if _, ok := list.index[name]; ok {
+				plugin.Shadowed = true
+				continue
+			}
+
+			list.index[name] = plugin
 		}
+	}
+
+	return list
+}
 
-		clients[name] = client
-		formatters[name] = formatter
+// pluginName returns the name of the plugin from its file info, and false if
+// the file is not a plugin.
+func pluginName(f os.FileInfo) (string, bool) {
+	if f.IsDir() || !isPluginFile(f.Name()) {
+		return "", false
 	}
+	return trimName(f.Name()), true
+}
 
-	return &List{formatters: formatters, clients: clients}, nil
+// isPluginFile returns true if file 'name' satisfies the naming convention.
+func isPluginFile(name string) bool {
+	return strings.HasPrefix(name, namePrefix) && trimName(name) != ""
 }
 
-func getPluginPath(dir string, name string) (string, error) {
-	suffix := ""
+// trimName returns the name of the plugin from its file name.
+func trimName(name string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(name, namePrefix), ".exe")
+}
 
-	if runtime.GOOS == "windows" {
-		suffix += ".exe"
+// start starts the 'plugin' and registers its client. A pinned plugin is only
+// started if its checksum matches.
+func (l *List) start(plugin *Plugin) error {
+	path, err := l.executable(plugin)
+	if err != nil {
+		return err
 	}
 
-	path := filepath.Join(dir, fmt.Sprintf("%s%s%s", namePrefix, name, suffix))
+	cmd, err := l.command(path)
+	if err != nil {
+		return err
+	}
+
+	client := pluginsdk.NewClient(&pluginsdk.ClientOpts{
+		Cmd: cmd,
+	})
 
-	if _, err := os.Stat(path); os.IsNotExist(err) {
-		return "", os.ErrNotExist
+	rpcClient, err := client.Client()
+	if err != nil {
+		client.Kill()
+		return err
 	}
 
-	return path, nil
+	raw, err := rpcClient.Dispense("formatter")
+	if err != nil {
+		client.Kill()
+		return err
+	}
+
+	formatter := raw.(*pluginsdk.Client)
+
+	version, err := formatter.Version()
+	if err != nil {
+		client.Kill()
+		return err
+	}
+
+	plugin.Version = version
+	plugin.Protocol = client.NegotiatedVersion()
+
+	l.clients[plugin.Name] = client
+	l.formatters[plugin.Name] = formatter
+
+	return nil
 }
@@ -0,0 +1,220 @@
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindPlugins(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "tfdocs-plugins")
+	assert.Nil(err)
+	defer os.RemoveAll(root)
+
+	first := filepath.Join(root, "first")
+	second := filepath.Join(root, "second")
+	files := []string{
+		filepath.Join(first, "tfdocs-format-foo"),
+		filepath.Join(first, "README.md"),
+		filepath.Join(second, "tfdocs-format-foo"),
+		filepath.Join(second, "tfdocs-format-bar"),
+	}
+	for _, f := range files {
+		assert.Nil(os.MkdirAll(filepath.Dir(f), 0755))
+		assert.Nil(ioutil.WriteFile(f, []byte{}, 0644))
+	}
+	assert.Nil(os.MkdirAll(filepath.Join(second, "tfdocs-format-dir"), 0755))
+
+	list := findPlugins([]string{first, filepath.Join(root, "noop"), second})
+	plugins := list.Plugins()
+
+	assert.Equal(3, len(plugins))
+
+	assert.Equal("foo", plugins[0].Name)
+	assert.Equal(files[0], plugins[0].Path)
+	assert.False(plugins[0].Shadowed)
+	assert.Nil(plugins[0].Err)
+
+	assert.Equal("bar", plugins[1].Name)
+	assert.Equal(files[3], plugins[1].Path)
+	assert.False(plugins[1].Shadowed)
+	assert.Nil(plugins[1].Err)
+
+	assert.Equal("foo", plugins[2].Name)
+	assert.Equal(files[2], plugins[2].Path)
+	assert.True(plugins[2].Shadowed)
+	assert.Nil(plugins[2].Err)
+}
+
+func TestListGet(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "tfdocs-plugins")
+	assert.Nil(err)
+	defer os.RemoveAll(root)
+
+	assert.Nil(ioutil.WriteFile(filepath.Join(root, "tfdocs-format-foo"), []byte{}, 0644))
+
+	list := findPlugins([]string{root})
+	defer list.Clean()
+
+	_, err = list.Get("bar")
+	assert.Equal(ErrNotFound, err)
+
+	_, err = list.Get("foo")
+	assert.NotNil(err)
+	assert.NotEqual(ErrNotFound, err)
+	assert.Equal(err, list.Plugins()[0].Err)
+
+	all := list.All()
+	assert.NotNil(all)
+	assert.Equal(0, len(all))
+}
+
+func TestListPin(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "tfdocs-plugins")
+	assert.Nil(err)
+	defer os.RemoveAll(root)
+
+	assert.Nil(ioutil.WriteFile(filepath.Join(root, "tfdocs-format-foo"), []byte{}, 0644))
+
+	list := findPlugins([]string{root})
+	defer list.Clean()
+
+	list.Pin("foo", wrongChecksum)
+
+	_, err = list.Get("foo")
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "checksum mismatch")
+}
+
+func TestListPinAll(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "tfdocs-plugins")
+	assert.Nil(err)
+	defer os.RemoveAll(root)
+
+	assert.Nil(ioutil.WriteFile(filepath.Join(root, "tfdocs-format-foo"), []byte{}, 0644))
+
+	list := findPlugins([]string{root})
+	defer list.Clean()
+
+	list.Pin("foo", wrongChecksum)
+
+	assert.Equal(0, len(list.All()))
+	assert.NotNil(list.Plugins()[0].Err)
+	assert.Contains(list.Plugins()[0].Err.Error(), "checksum mismatch")
+}
+
+func TestListExecutable(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "tfdocs-plugins")
+	assert.Nil(err)
+	defer os.RemoveAll(root)
+
+	path := filepath.Join(root, "tfdocs-format-foo")
+	assert.Nil(ioutil.WriteFile(path, []byte("foo"), 0755))
+	checksum, err := Checksum(path)
+	assert.Nil(err)
+
+	list := findPlugins([]string{root})
+	plugin := list.Plugins()[0]
+
+	// not pinned plugin is executed as is
+	actual, err := list.executable(plugin)
+	assert.Nil(err)
+	assert.Equal(path, actual)
+
+	// pinned plugin is executed from a verified copy
+	list.Pin("foo", checksum)
+	actual, err = list.executable(plugin)
+	assert.Nil(err)
+	assert.NotEqual(path, actual)
+
+	assert.Nil(ioutil.WriteFile(path, []byte("bar"), 0755))
+	content, err := ioutil.ReadFile(actual)
+	assert.Nil(err)
+	assert.Equal("foo", string(content))
+
+	_, err = list.executable(plugin)
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "checksum mismatch")
+
+	list.Clean()
+	_, err = os.Stat(actual)
+	assert.True(os.IsNotExist(err))
+}
+
+func TestListCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not available")
+	}
+
+	for _, key := range []string{"TFDOCS_CONFIG", "TFDOCS_PLUGIN_OPTIONS"} {
+		if value, ok := os.LookupEnv(key); ok {
+			defer os.Setenv(key, value) //nolint:errcheck
+		} else {
+			defer os.Unsetenv(key) //nolint:errcheck
+		}
+		os.Unsetenv(key) //nolint:errcheck
+	}
+
+	list := findPlugins([]string{})
+	list.Setenv("TFDOCS_CONFIG", "forwarded")
+	list.Setenv("TFDOCS_PLUGIN_OPTIONS", "foo: bar")
+
+	sh, err := exec.LookPath("sh")
+	assert.Nil(err)
+
+	cmd, err := list.command(sh)
+	assert.Nil(err)
+	assert.Equal([]string{"TFDOCS_CONFIG=forwarded", "TFDOCS_PLUGIN_OPTIONS=foo: bar"}, cmd.Env)
+
+	// environment of the command is followed by the inherited one, the same
+	// as go-plugin does when it starts a plugin
+	cmd.Args = append(cmd.Args, "-c", "echo \"$TFDOCS_CONFIG,$TFDOCS_PLUGIN_OPTIONS\"")
+	cmd.Env = append(cmd.Env, os.Environ()...)
+	out, err := cmd.Output()
+
+	assert.Nil(err)
+	assert.Equal("forwarded,foo: bar\n", string(out))
+
+	_, found := os.LookupEnv("TFDOCS_CONFIG")
+	assert.False(found)
+
+	// inherited with the same value is passed as is
+	os.Setenv("TFDOCS_PLUGIN_OPTIONS", "foo: bar") //nolint:errcheck
+	_, err = list.command(sh)
+	assert.Nil(err)
+
+	// inherited with another value would override the forwarded one
+	os.Setenv("TFDOCS_PLUGIN_OPTIONS", "inherited") //nolint:errcheck
+	_, err = list.command(sh)
+	assert.EqualError(err, "environment variable 'TFDOCS_PLUGIN_OPTIONS' is reserved for plugins and can't be set")
+}
+
+func TestPluginDirs(t *testing.T) {
+	assert := assert.New(t)
+
+	env := os.Getenv("TFDOCS_PLUGIN_DIR")
+	defer os.Setenv("TFDOCS_PLUGIN_DIR", env) //nolint:errcheck
+
+	os.Setenv("TFDOCS_PLUGIN_DIR", "foo"+string(os.PathListSeparator)+"bar"+string(os.PathListSeparator)+localPluginsRoot) //nolint:errcheck
+
+	dirs, err := pluginDirs()
+	assert.Nil(err)
+	assert.Equal(4, len(dirs))
+	assert.Equal([]string{"foo", "bar", localPluginsRoot}, dirs[:3])
+}
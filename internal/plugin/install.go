@@ -0,0 +1,205 @@
+package plugin
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Install installs the plugin from 'source' into 'dir'. Source can be path
+// of a local file or a http(s) URL, pointing to either the plugin binary or
+// a .zip, .tar.gz or .tgz archive containing it. The binary must satisfy the
+// "tfdocs-format-*" naming convention.
+//
+// If 'checksum' is provided, the SHA256 checksum of the plugin binary must
+// match it, otherwise nothing is installed. Installing from a URL requires
+// a checksum.
+func Install(source string, dir string, checksum string) (*Plugin, string, error) {
+	remote := strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://")
+	if remote && checksum == "" {
+		return nil, "", fmt.Errorf("checksum is required to install plugin from '%s'", source)
+	}
+
+	var content []byte
+	var err error
+	if remote {
+		content, err = download(source)
+	} else {
+		content, err = ioutil.ReadFile(source)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	name, binary, err := extract(source, content)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sum := sha256sum(binary)
+	if checksum != "" && !strings.EqualFold(checksum, sum) {
+		return nil, "", fmt.Errorf("checksum mismatch for '%s': expected %s, got %s", name, checksum, sum)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, "", err
+	}
+
+	// write to a temporary file first and move it in place, so a running
+	// or an existing plugin isn't left half-written
+	tmp, err := ioutil.TempFile(dir, ".install-")
+	if err != nil {
+		return nil, "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(binary); err != nil {
+		tmp.Close()
+		return nil, "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, "", err
+	}
+	if err := os.Chmod(tmp.Name(), 0755); err != nil {
+		return nil, "", err
+	}
+
+	target := filepath.Join(dir, name)
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return nil, "", err
+	}
+
+	plugin := &Plugin{
+		Name: trimName(name),
+		Path: target,
+	}
+	return plugin, sum, nil
+}
+
+// Checksum returns SHA256 checksum of the file at 'path' in hex.
+func Checksum(path string) (string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return sha256sum(content), nil
+}
+
+func sha256sum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func download(url string) ([]byte, error) {
+	resp, err := http.Get(url) //nolint:gosec,noctx
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download '%s': %s", url, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// extract returns file name and content of the plugin binary from 'content'
+// of 'source', which is either the plugin binary itself or an archive.
+func extract(source string, content []byte) (string, []byte, error) {
+	base := path.Base(filepath.ToSlash(source))
+	if i := strings.IndexAny(base, "?#"); i != -1 {
+		base = base[:i]
+	}
+
+	switch {
+	case strings.HasSuffix(base, ".zip"):
+		return extractZip(source, content)
+	case strings.HasSuffix(base, ".tar.gz"), strings.HasSuffix(base, ".tgz"):
+		return extractTarGz(source, content)
+	}
+
+	if !isPluginFile(base) {
+		return "", nil, fmt.Errorf("'%s' is not a plugin, file name must start with '%s'", source, namePrefix)
+	}
+	return base, content, nil
+}
+
+func extractZip(source string, content []byte) (string, []byte, error) {
+	reader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return "", nil, err
+	}
+
+	var found *zip.File
+	for _, f := range reader.File {
+		if f.FileInfo().IsDir() || !isPluginFile(path.Base(f.Name)) {
+			continue
+		}
+		if found != nil {
+			return "", nil, fmt.Errorf("archive '%s' contains more than one plugin", source)
+		}
+		found = f
+	}
+	if found == nil {
+		return "", nil, fmt.Errorf("archive '%s' doesn't contain any plugin", source)
+	}
+
+	rc, err := found.Open()
+	if err != nil {
+		return "", nil, err
+	}
+	defer rc.Close()
+
+	binary, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return "", nil, err
+	}
+	return path.Base(found.Name), binary, nil
+}
+
+func extractTarGz(source string, content []byte) (string, []byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(content))
+	if err != nil {
+		return "", nil, err
+	}
+	defer gz.Close()
+
+	var name string
+	var binary []byte
+
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		if header.Typeflag != tar.TypeReg || !isPluginFile(path.Base(header.Name)) {
+			continue
+		}
+		if name != "" {
+			return "", nil, fmt.Errorf("archive '%s' contains more than one plugin", source)
+		}
+		name = path.Base(header.Name)
+		if binary, err = ioutil.ReadAll(reader); err != nil {
+			return "", nil, err
+		}
+	}
+	if name == "" {
+		return "", nil, fmt.Errorf("archive '%s' doesn't contain any plugin", source)
+	}
+	return name, binary, nil
+}
@@ -0,0 +1,208 @@
+package plugin
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var fakePlugin = []byte("fake plugin")
+
+const wrongChecksum = "9d9fb55f47f4f4d5bcb16a6d6a4ab7d5ea5ec1f5b1b3e9fa6f4a36e2baf3c1f1"
+
+func TestInstall(t *testing.T) {
+	root, err := ioutil.TempDir("", "tfdocs-install")
+	assert.Nil(t, err)
+	defer os.RemoveAll(root)
+
+	sum := sha256sum(fakePlugin)
+
+	files := map[string][]byte{
+		"tfdocs-format-foo":     fakePlugin,
+		"foo":                   fakePlugin,
+		"foo.zip":               zipOf(t, map[string][]byte{"README.md": {}, "bin/tfdocs-format-foo": fakePlugin}),
+		"foo.tar.gz":            tarOf(t, map[string][]byte{"README.md": {}, "tfdocs-format-foo": fakePlugin}),
+		"foo.tgz":               tarOf(t, map[string][]byte{"tfdocs-format-foo.exe": fakePlugin}),
+		"empty.zip":             zipOf(t, map[string][]byte{"README.md": {}}),
+		"multiple.tar.gz":       tarOf(t, map[string][]byte{"tfdocs-format-foo": fakePlugin, "tfdocs-format-bar": fakePlugin}),
+		"tfdocs-format-foo.zip": fakePlugin,
+	}
+	for name, c := range files {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(root, name), c, 0644))
+	}
+
+	tests := []struct {
+		name     string
+		source   string
+		checksum string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "install plugin binary",
+			source:   "tfdocs-format-foo",
+			checksum: "",
+			expected: "tfdocs-format-foo",
+			wantErr:  false,
+		},
+		{
+			name:     "install plugin binary with checksum",
+			source:   "tfdocs-format-foo",
+			checksum: sum,
+			expected: "tfdocs-format-foo",
+			wantErr:  false,
+		},
+		{
+			name:     "install plugin binary with wrong checksum",
+			source:   "tfdocs-format-foo",
+			checksum: wrongChecksum,
+			expected: "",
+			wantErr:  true,
+		},
+		{
+			name:     "install plugin from zip",
+			source:   "foo.zip",
+			checksum: sum,
+			expected: "tfdocs-format-foo",
+			wantErr:  false,
+		},
+		{
+			name:     "install plugin from tar.gz",
+			source:   "foo.tar.gz",
+			checksum: sum,
+			expected: "tfdocs-format-foo",
+			wantErr:  false,
+		},
+		{
+			name:     "install plugin from tgz",
+			source:   "foo.tgz",
+			checksum: sum,
+			expected: "tfdocs-format-foo.exe",
+			wantErr:  false,
+		},
+		{
+			name:     "install file not satisfying naming convention",
+			source:   "foo",
+			checksum: "",
+			expected: "",
+			wantErr:  true,
+		},
+		{
+			name:     "install from archive without plugin",
+			source:   "empty.zip",
+			checksum: "",
+			expected: "",
+			wantErr:  true,
+		},
+		{
+			name:     "install from archive with multiple plugins",
+			source:   "multiple.tar.gz",
+			checksum: "",
+			expected: "",
+			wantErr:  true,
+		},
+		{
+			name:     "install from malformed archive",
+			source:   "tfdocs-format-foo.zip",
+			checksum: "",
+			expected: "",
+			wantErr:  true,
+		},
+		{
+			name:     "install from missing file",
+			source:   "noop",
+			checksum: "",
+			expected: "",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			dir := filepath.Join(root, "plugins")
+			defer os.RemoveAll(dir)
+
+			plugin, actual, err := Install(filepath.Join(root, tt.source), dir, tt.checksum)
+			if tt.wantErr {
+				assert.NotNil(err)
+
+				_, err := os.Stat(filepath.Join(dir, "tfdocs-format-foo"))
+				assert.True(os.IsNotExist(err))
+			} else {
+				assert.Nil(err)
+				assert.Equal(sum, actual)
+				assert.Equal("foo", plugin.Name)
+				assert.Equal(filepath.Join(dir, tt.expected), plugin.Path)
+
+				installed, err := ioutil.ReadFile(plugin.Path)
+				assert.Nil(err)
+				assert.Equal(fakePlugin, installed)
+			}
+		})
+	}
+}
+
+func TestInstallURL(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tfdocs-format-foo" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(fakePlugin) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "tfdocs-install")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+
+	_, _, err = Install(server.URL+"/tfdocs-format-foo", dir, "")
+	assert.NotNil(err)
+
+	_, _, err = Install(server.URL+"/tfdocs-format-bar", dir, wrongChecksum)
+	assert.NotNil(err)
+
+	plugin, sum, err := Install(server.URL+"/tfdocs-format-foo", dir, sha256sum(fakePlugin))
+	assert.Nil(err)
+	assert.Equal("foo", plugin.Name)
+	assert.Equal(sha256sum(fakePlugin), sum)
+}
+
+func zipOf(t *testing.T, files map[string][]byte) []byte {
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	for name, c := range files {
+		f, err := w.Create(name)
+		assert.Nil(t, err)
+		_, err = f.Write(c)
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, w.Close())
+	return buf.Bytes()
+}
+
+func tarOf(t *testing.T, files map[string][]byte) []byte {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	w := tar.NewWriter(gz)
+	for name, c := range files {
+		assert.Nil(t, w.WriteHeader(&tar.Header{Name: name, Mode: 0755, Size: int64(len(c)), Typeflag: tar.TypeReg}))
+		_, err := w.Write(c)
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, w.Close())
+	assert.Nil(t, gz.Close())
+	return buf.Bytes()
+}
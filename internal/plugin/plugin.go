@@ -1,6 +1,14 @@
 package plugin
 
 import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
 	goplugin "github.com/hashicorp/go-plugin"
 
 	pluginsdk "github.com/terraform-docs/plugin-sdk/plugin"
@@ -15,32 +23,147 @@ const namePrefix = "tfdocs-format-"
 var homePluginsRoot = "~/.tfdocs.d/plugins"
 var localPluginsRoot = "./.tfdocs.d/plugins"
 
+// LocalDir returns the plugins directory of the current directory, which is
+// the default directory plugins are installed in.
+func LocalDir() string {
+	return localPluginsRoot
+}
+
 // List is an object caching discovered plugins and their corresponding
 // clients. Basically, it is a wrapper for go-plugin and provides an API
 // to handle them collectively.
 type List struct {
 	formatters map[string]*pluginsdk.Client
 	clients    map[string]*goplugin.Client
+	plugins    []*Plugin
+	index      map[string]*Plugin
+	checksums  map[string]string
+	env        []string
+	tempdirs   []string
+}
+
+// ErrNotFound is returned when a plugin with a given name is not discovered.
+var ErrNotFound = errors.New("plugin not found")
+
+// Plugin represents a discovered plugin, whether it's registered or not.
+type Plugin struct {
+	Name     string // name of the plugin, file name without "tfdocs-format-" prefix
+	Path     string // path of the plugin file
+	Version  string // version reported by the plugin, once it's started
+	Protocol int    // negotiated protocol version of plugin-sdk, once it's started
+	Shadowed bool   // plugin with the same name is found with higher precedence
+	Err      error  // error occurred starting the plugin
+}
+
+// Plugins returns all discovered plugins, including the shadowed ones and the
+// ones failed to start, in the order of precedence of their directories.
+func (l *List) Plugins() []*Plugin {
+	return l.plugins
 }
 
-// All returns all registered plugins.
+// All starts all the discovered plugins, which are not shadowed or started
+// already, and returns the ones started successfully. Errors of the plugins
+// failed to start are available in Plugins.
 func (l *List) All() []*pluginsdk.Client {
-	all := make([]*pluginsdk.Client, len(l.formatters)-1)
-	for _, f := range l.formatters {
-		all = append(all, f)
+	all := make([]*pluginsdk.Client, 0, len(l.index))
+	for _, p := range l.plugins {
+		if p.Shadowed || p.Name == "" {
+			continue
+		}
+		if client, err := l.Get(p.Name); err == nil {
+			all = append(all, client)
+		}
 	}
 	return all
 }
 
-// Get plugin by its name.
-func (l *List) Get(name string) (*pluginsdk.Client, bool) {
-	client, ok := l.formatters[name]
-	return client, ok
+// Get plugin by its name. The plugin is started if it's not started already.
+// ErrNotFound is returned if plugin with 'name' is not discovered.
+func (l *List) Get(name string) (*pluginsdk.Client, error) {
+	if client, ok := l.formatters[name]; ok {
+		return client, nil
+	}
+	plugin, ok := l.index[name]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if plugin.Err != nil {
+		return nil, plugin.Err
+	}
+	if err := l.start(plugin); err != nil {
+		plugin.Err = err
+		return nil, err
+	}
+	return l.formatters[name], nil
+}
+
+// Pin sets the expected SHA256 'checksum' of plugin 'name'. A pinned plugin
+// is refused to be started, by any of Get or All, if checksum of its file
+// doesn't match.
+func (l *List) Pin(name string, checksum string) {
+	l.checksums[name] = checksum
 }
 
-// Clean is a helper for ending plugin processes.
+// Setenv sets the environment variable 'key' to 'value' for the plugins
+// started afterwards, in addition to the environment of the current process.
+func (l *List) Setenv(key string, value string) {
+	l.env = append(l.env, key+"="+value)
+}
+
+// command returns the command which executes the file at 'path' with the
+// environment variables set with Setenv. go-plugin appends the environment of
+// the current process to the one of the command, and the last value of a
+// variable wins, hence an error is returned if any of them is inherited with a
+// different value, instead of silently passing the inherited one.
+func (l *List) command(path string) (*exec.Cmd, error) {
+	for _, kv := range l.env {
+		parts := strings.SplitN(kv, "=", 2)
+		if value, ok := os.LookupEnv(parts[0]); ok && value != parts[1] {
+			return nil, fmt.Errorf("environment variable '%s' is reserved for plugins and can't be set", parts[0])
+		}
+	}
+	cmd := exec.Command(path)
+	cmd.Env = append([]string{}, l.env...)
+	return cmd, nil
+}
+
+// executable returns path of the file to execute for 'plugin'. If the plugin is
+// pinned, its file is read once, checked against the pinned checksum and copied
+// to a private temporary directory, and the copy is executed instead. Hence the
+// file can't be replaced between being verified and being executed.
+func (l *List) executable(plugin *Plugin) (string, error) {
+	expected, ok := l.checksums[plugin.Name]
+	if !ok {
+		return plugin.Path, nil
+	}
+	content, err := ioutil.ReadFile(plugin.Path)
+	if err != nil {
+		return "", err
+	}
+	if actual := sha256sum(content); !strings.EqualFold(expected, actual) {
+		return "", fmt.Errorf("checksum mismatch for '%s': expected %s, got %s", plugin.Path, expected, actual)
+	}
+	dir, err := ioutil.TempDir("", "tfdocs-plugin")
+	if err != nil {
+		return "", err
+	}
+	l.tempdirs = append(l.tempdirs, dir)
+	path := filepath.Join(dir, filepath.Base(plugin.Path))
+	if err := ioutil.WriteFile(path, content, 0700); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// Clean is a helper for ending processes of started plugins.
 func (l *List) Clean() {
-	for _, client := range l.clients {
+	for name, client := range l.clients {
 		client.Kill()
+		delete(l.clients, name)
+		delete(l.formatters, name)
+	}
+	for _, dir := range l.tempdirs {
+		os.RemoveAll(dir)
 	}
+	l.tempdirs = nil
 }
@@ -12,6 +12,8 @@ package print
 
 import (
 	printsdk "github.com/terraform-docs/plugin-sdk/print"
+
+	"github.com/terraform-docs/terraform-docs/internal/schema"
 )
 
 // Settings represents all settings.
@@ -28,10 +30,10 @@ type Settings struct {
 	// scope: Markdown
 	EscapePipe bool
 
-	// IndentLevel control the indentation of AsciiDoc and Markdown headers [available: 1, 2, 3, 4, 5]
+	// IndentLevel control the indentation of AsciiDoc, Markdown and reStructuredText headers [available: 1, 2, 3, 4, 5]
 	//
 	// default: 2
-	// scope: Asciidoc, Markdown
+	// scope: Asciidoc, Markdown, RST
 	IndentLevel int
 
 	// OutputValues extract and show Output values from Terraform module output
@@ -40,18 +42,51 @@ type Settings struct {
 	// scope: Global
 	OutputValues bool
 
+	// SchemaVersion version of the schema used to render JSON, TOML, XML and YAML [available: 1, 2]
+	//
+	// default: 1
+	// scope: JSON, TOML, XML, YAML
+	SchemaVersion int
+
+	// ShowAnchor show stable anchors of inputs and outputs, e.g. 'input_foo', to be able to link to them
+	//
+	// default: false
+	// scope: Asciidoc document, Markdown document
+	ShowAnchor bool
+
 	// ShowColor print "colorized" version of result in the terminal
 	//
 	// default: true
 	// scope: Pretty
 	ShowColor bool
 
+	// ShowDataFlow show "Data flow" section with resources and outputs affected
+	// by each input, and include the data flow of the module in structured output
+	//
+	// default: false
+	// scope: Markdown, JSON, TOML, XML, YAML
+	ShowDataFlow bool
+
+	// ShowDiagram show "Diagram" section with Mermaid flowchart of providers,
+	// resources and module calls
+	//
+	// default: false
+	// scope: Markdown
+	ShowDiagram bool
+
 	// ShowHeader show "Header" module information
 	//
 	// default: true
 	// scope: Global
 	ShowHeader bool
 
+	// ShowInputReferences show "Used by" column of inputs with the items of the
+	// module referencing them, in which unused inputs are flagged
+	//
+	// default: false
+	// scope: Markdown table, JSON, TOML, XML, YAML
+	ShowInputReferences bool
+
 	// ShowInputs show "Inputs" information
 	//
 	// default: true
@@ -70,6 +105,13 @@ type Settings struct {
 	// scope: Global
 	ShowOutputs bool
 
+	// ShowOutputSources show "Derived from" column of outputs with the inputs,
+	// resources, data sources and module outputs their value is derived from
+	//
+	// default: false
+	// scope: Markdown table, JSON, TOML, XML, YAML
+	ShowOutputSources bool
+
 	// ShowProviders show "Providers" information
 	//
 	// default: true
@@ -79,13 +121,13 @@ type Settings struct {
 	// ShowRequired show "Required" column when generating Markdown
 	//
 	// default: true
-	// scope: Markdown
+	// scope: Confluence, HTML, Markdown, RST
 	ShowRequired bool
 
 	// ShowSensitivity show "Sensitive" column when generating Markdown
 	//
 	// default: true
-	// scope: Markdown
+	// scope: Confluence, HTML, Markdown, RST
 	ShowSensitivity bool
 
 	// ShowRequirements show "Requirements" section
@@ -100,6 +142,12 @@ type Settings struct {
 	// scope: Global
 	ShowResources bool
 
+	// ShowTOC show table of contents with links to sections, inputs and outputs, which implies ShowAnchor
+	//
+	// default: false
+	// scope: Asciidoc document, Markdown document
+	ShowTOC bool
+
 	// SortByName sorted rendering of inputs and outputs
 	//
 	// default: true
@@ -117,28 +165,58 @@ type Settings struct {
 	// default: false
 	// scope: Global
 	SortByType bool
+
+	// Templates overrides the named templates used by Asciidoc, Markdown, Pretty and RST,
+	// with name of the template as key and its content as value
+	//
+	// default: {}
+	// scope: Asciidoc, Markdown, Pretty, RST, tfvars hcl
+	Templates map[string]string
+
+	// TfvarsDescription show "Description" of inputs as comments, and group
+	// required inputs first with placeholder values
+	//
+	// default: false
+	// scope: tfvars hcl
+	TfvarsDescription bool
+
+	// ValueFormat format of default values of inputs and values of outputs [available: json, hcl]
+	//
+	// default: json
+	// scope: Asciidoc, Markdown, Pretty, RST, tfvars hcl
+	ValueFormat string
 }
 
 // DefaultSettings returns new instance of Settings
 func DefaultSettings() *Settings {
 	return &Settings{
-		EscapeCharacters: true,
-		EscapePipe:       true,
-		IndentLevel:      2,
-		OutputValues:     false,
-		ShowColor:        true,
-		ShowHeader:       true,
-		ShowInputs:       true,
-		ShowModuleCalls:  true,
-		ShowOutputs:      true,
-		ShowProviders:    true,
-		ShowRequired:     true,
-		ShowSensitivity:  true,
-		ShowRequirements: true,
-		ShowResources:    true,
-		SortByName:       true,
-		SortByRequired:   false,
-		SortByType:       false,
+		EscapeCharacters:    true,
+		EscapePipe:          true,
+		IndentLevel:         2,
+		OutputValues:        false,
+		SchemaVersion:       schema.DefaultVersion,
+		ShowAnchor:          false,
+		ShowColor:           true,
+		ShowDataFlow:        false,
+		ShowDiagram:         false,
+		ShowHeader:          true,
+		ShowInputReferences: false,
+		ShowInputs:          true,
+		ShowModuleCalls:     true,
+		ShowOutputs:         true,
+		ShowOutputSources:   false,
+		ShowProviders:       true,
+		ShowRequired:        true,
+		ShowSensitivity:     true,
+		ShowRequirements:    true,
+		ShowResources:       true,
+		ShowTOC:             false,
+		SortByName:          true,
+		SortByRequired:      false,
+		SortByType:          false,
+		Templates:           map[string]string{},
+		TfvarsDescription:   false,
+		ValueFormat:         "json",
 	}
 }
 
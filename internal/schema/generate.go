@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+const draft = "http://json-schema.org/draft-07/schema#"
+
+// node represents a (sub)schema of a JSON Schema document.
+type node struct {
+	Schema      string           `json:"$schema,omitempty"`
+	Title       string           `json:"title,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Ref         string           `json:"$ref,omitempty"`
+	Type        interface{}      `json:"type,omitempty"`
+	Const       interface{}      `json:"const,omitempty"`
+	Items       *node            `json:"items,omitempty"`
+	Properties  map[string]*node `json:"properties,omitempty"`
+	Required    []string         `json:"required,omitempty"`
+	Definitions map[string]*node `json:"definitions,omitempty"`
+}
+
+var (
+	typeOfString = reflect.TypeOf(types.String(""))
+	typeOfValue  = reflect.TypeOf((*types.Value)(nil)).Elem()
+)
+
+// Generate returns the JSON Schema (draft-07) document describing the JSON
+// output of terraform-docs in the provided schema 'version'.
+func Generate(version int) (string, error) {
+	if !IsSupported(version) {
+		return "", fmt.Errorf("schema version '%d' is not supported", version)
+	}
+
+	var root reflect.Type
+	switch version {
+	case Version1:
+		root = reflect.TypeOf(moduleV1{})
+	default:
+		root = reflect.TypeOf(terraform.Module{})
+	}
+
+	definitions := make(map[string]*node)
+	document := object(root, definitions, nil)
+	document.Schema = draft
+	document.Title = "terraform-docs"
+	document.Description = fmt.Sprintf("Structured output of terraform-docs, schema version %d", version)
+	document.Properties["schemaVersion"] = &node{Type: "integer", Const: version}
+	document.Definitions = definitions
+
+	if version == Version1 {
+		for name, keys := range version2Fields {
+			n := definitions[name]
+			if name == "module" {
+				n = document
+			}
+			for _, key := range keys {
+				delete(n.Properties, key) // fields added in Version2 are all optional
+			}
+		}
+		document.Definitions = referenced(document, definitions)
+	}
+
+	buffer := new(bytes.Buffer)
+
+	encoder := json.NewEncoder(buffer)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(document); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+// object returns the schema of struct type 't' with all its exported fields
+// as properties. Fields of embedded structs are promoted, same as encoding/json
+// does, and a field of the outer struct shadows the embedded one. Fields named
+// in 'skip' are ignored.
+func object(t reflect.Type, definitions map[string]*node, skip map[string]bool) *node {
+	n := &node{
+		Type:       "object",
+		Properties: make(map[string]*node),
+		Required:   make([]string, 0),
+	}
+	shadowed := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		if name, _ := jsonName(t.Field(i)); !t.Field(i).Anonymous && name != "" {
+			shadowed[name] = true
+		}
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous {
+			inner := object(indirect(f.Type), definitions, shadowed)
+			n.Required = append(n.Required, inner.Required...)
+			for name, p := range inner.Properties {
+				n.Properties[name] = p
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		name, omitempty := jsonName(f)
+		if name == "" || skip[name] {
+			continue
+		}
+		n.Properties[name] = property(f.Type, definitions)
+		if !omitempty {
+			n.Required = append(n.Required, name)
+		}
+	}
+	return n
+}
+
+// property returns the schema of type 't'. Structs are added to 'definitions'
+// and are referenced by their name.
+func property(t reflect.Type, definitions map[string]*node) *node {
+	switch {
+	case t == typeOfString:
+		return &node{Type: []string{"string", "null"}}
+	case t == typeOfValue:
+		return &node{}
+	}
+	t = indirect(t)
+	switch t.Kind() {
+	case reflect.String:
+		return &node{Type: "string"}
+	case reflect.Bool:
+		return &node{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &node{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &node{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &node{Type: "array", Items: property(t.Elem(), definitions)}
+	case reflect.Struct:
+		name := definitionName(t)
+		if _, ok := definitions[name]; !ok {
+			definitions[name] = nil // guard against recursive types
+			definitions[name] = object(t, definitions, nil)
+		}
+		return &node{Ref: "#/definitions/" + name}
+	}
+	return &node{}
+}
+
+// referenced returns the 'definitions' referenced by 'n', either directly or
+// through the other definitions.
+func referenced(n *node, definitions map[string]*node) map[string]*node {
+	result := make(map[string]*node)
+	var walk func(n *node)
+	walk = func(n *node) {
+		if n == nil {
+			return
+		}
+		if name := strings.TrimPrefix(n.Ref, "#/definitions/"); n.Ref != "" {
+			if _, ok := result[name]; !ok {
+				result[name] = definitions[name]
+				walk(definitions[name])
+			}
+		}
+		walk(n.Items)
+		for _, p := range n.Properties {
+			walk(p)
+		}
+	}
+	walk(n)
+	return result
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+	return t
+}
+
+// definitionName returns the name of type 't' in definitions, versioned
+// types (e.g. resourceV1) are stored under their unversioned name.
+func definitionName(t reflect.Type) string {
+	return strings.ToLower(strings.TrimSuffix(t.Name(), "V1"))
+}
+
+// jsonName returns name of the field 'f' based on its 'json' tag and whether
+// or not it's marked as 'omitempty'. Empty name is returned if field is ignored.
+func jsonName(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	parts := strings.Split(tag, ",")
+	name := parts[0]
+	if name == "" {
+		name = f.Name
+	}
+	omitempty := false
+	for _, p := range parts[1:] {
+		if p == "omitempty" {
+			omitempty = true
+		}
+	}
+	return name, omitempty
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package schema
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int
+		golden  string
+	}{
+		{
+			name:    "version 1",
+			version: 1,
+			golden:  "v1",
+		},
+		{
+			name:    "version 2",
+			version: 2,
+			golden:  "v2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			// testutil can't be used, as it depends on print, which depends on schema
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", tt.golden+".json.golden"))
+			assert.Nil(err)
+
+			actual, err := Generate(tt.version)
+
+			assert.Nil(err)
+			assert.Equal(string(expected), actual)
+		})
+	}
+}
+
+func TestGenerateUnsupported(t *testing.T) {
+	assert := assert.New(t)
+	actual, err := Generate(100)
+
+	assert.NotNil(err)
+	assert.Equal("", actual)
+}
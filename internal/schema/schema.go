@@ -0,0 +1,142 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package schema
+
+import (
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+const (
+	// Version1 is the initial schema of the structured outputs. It keeps the
+	// misspelled 'provicerSource' key of resources in JSON for compatibility.
+	Version1 = 1
+
+	// Version2 renames the 'provicerSource' key of resources in JSON to
+	// 'providerSource', same as it has always been in TOML, XML and YAML. It
+	// also adds the 'dataFlow' key of module, the 'references' and 'unused'
+	// keys of inputs and the 'sources' key of outputs.
+	Version2 = 2
+
+	// DefaultVersion is the version being used if none is explicitly selected.
+	DefaultVersion = Version1
+
+	// LatestVersion is the most recent available version.
+	LatestVersion = Version2
+)
+
+// Versions returns list of all the supported schema versions.
+func Versions() []int {
+	return []int{Version1, Version2}
+}
+
+// IsSupported indicates if the schema 'version' is supported.
+func IsSupported(version int) bool {
+	for _, v := range Versions() {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolve returns the provided 'version' if it's supported, otherwise
+// it falls back to DefaultVersion.
+func Resolve(version int) int {
+	if IsSupported(version) {
+		return version
+	}
+	return DefaultVersion
+}
+
+// Versioned returns the JSON encodable representation of 'module' based on
+// the provided schema 'version', with its 'SchemaVersion' set accordingly.
+// 'module' itself isn't modified.
+func Versioned(module *terraform.Module, version int) interface{} {
+	module = Restrict(module, version)
+	if module.SchemaVersion == Version1 {
+		return newModuleV1(module)
+	}
+	return module
+}
+
+// version2Fields are the keys of the fields added in Version2, by the name
+// of their type in the schema.
+var version2Fields = map[string][]string{
+	"module": {"dataFlow"},
+	"input":  {"references", "unused"},
+	"output": {"sources"},
+}
+
+// Restrict returns a copy of 'module' with its 'SchemaVersion' set to the
+// provided schema 'version', and the fields which aren't part of the version
+// left empty, hence they're omitted from all the structured outputs. 'module'
+// itself isn't modified.
+func Restrict(module *terraform.Module, version int) *terraform.Module {
+	copy := *module
+	copy.SchemaVersion = Resolve(version)
+	if copy.SchemaVersion != Version1 {
+		return &copy
+	}
+
+	copy.DataFlow = nil
+	if module.Inputs != nil {
+		copy.Inputs = make([]*terraform.Input, 0, len(module.Inputs))
+		for _, i := range module.Inputs {
+			input := *i
+			input.References = nil
+			input.Unused = false
+			copy.Inputs = append(copy.Inputs, &input)
+		}
+	}
+	if module.Outputs != nil {
+		copy.Outputs = make([]*terraform.Output, 0, len(module.Outputs))
+		for _, o := range module.Outputs {
+			output := *o
+			output.Sources = nil
+			copy.Outputs = append(copy.Outputs, &output)
+		}
+	}
+	return &copy
+}
+
+// moduleV1 is the JSON representation of terraform.Module in schema version 1.
+type moduleV1 struct {
+	*terraform.Module
+
+	Resources []*resourceV1 `json:"resources"`
+}
+
+// resourceV1 is the JSON representation of terraform.Resource in schema version 1.
+type resourceV1 struct {
+	Type           string       `json:"type"`
+	ProviderName   string       `json:"providerName"`
+	ProviderSource string       `json:"provicerSource"`
+	Mode           string       `json:"mode"`
+	Version        types.String `json:"version"`
+}
+
+func newModuleV1(module *terraform.Module) *moduleV1 {
+	resources := make([]*resourceV1, 0, len(module.Resources))
+	for _, r := range module.Resources {
+		resources = append(resources, &resourceV1{
+			Type:           r.Type,
+			ProviderName:   r.ProviderName,
+			ProviderSource: r.ProviderSource,
+			Mode:           r.Mode,
+			Version:        r.Version,
+		})
+	}
+	return &moduleV1{
+		Module:    module,
+		Resources: resources,
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+func TestSchemaResolve(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  int
+		expected int
+	}{
+		{
+			name:     "version 1",
+			version:  1,
+			expected: Version1,
+		},
+		{
+			name:     "version 2",
+			version:  2,
+			expected: Version2,
+		},
+		{
+			name:     "zero value",
+			version:  0,
+			expected: DefaultVersion,
+		},
+		{
+			name:     "unsupported version",
+			version:  100,
+			expected: DefaultVersion,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, Resolve(tt.version))
+		})
+	}
+}
+
+func TestSchemaVersioned(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  int
+		expected string
+	}{
+		{
+			name:     "version 1",
+			version:  1,
+			expected: `{"schemaVersion":1,"header":"","inputs":null,"modules":null,"outputs":null,"providers":null,"requirements":null,"resources":[{"type":"resource","providerName":"null","provicerSource":"hashicorp/null","mode":"managed","version":"latest"}]}`,
+		},
+		{
+			name:     "version 2",
+			version:  2,
+			expected: `{"schemaVersion":2,"header":"","inputs":null,"modules":null,"outputs":null,"providers":null,"requirements":null,"resources":[{"type":"resource","providerName":"null","providerSource":"hashicorp/null","mode":"managed","version":"latest"}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			module := &terraform.Module{
+				Resources: []*terraform.Resource{
+					{
+						Type:           "resource",
+						ProviderName:   "null",
+						ProviderSource: "hashicorp/null",
+						Mode:           "managed",
+						Version:        types.String("latest"),
+					},
+				},
+			}
+			actual, err := json.Marshal(Versioned(module, tt.version))
+
+			assert.Nil(err)
+			assert.Equal(0, module.SchemaVersion)
+			assert.Equal(tt.expected, string(actual))
+		})
+	}
+}
+
+func TestSchemaRestrict(t *testing.T) {
+	module := &terraform.Module{
+		Inputs: []*terraform.Input{
+			{
+				Name:       "foo",
+				References: []*terraform.Reference{{Address: "output.bar", Filename: "main.tf", Line: 1}},
+				Unused:     true,
+			},
+		},
+		Outputs: []*terraform.Output{
+			{
+				Name:    "bar",
+				Sources: []string{"var.foo"},
+			},
+		},
+		DataFlow: []*terraform.DataFlow{{From: "var.foo", To: "output.bar"}},
+	}
+	tests := []struct {
+		name     string
+		version  int
+		expected string
+	}{
+		{
+			name:     "version 1",
+			version:  1,
+			expected: `{"schemaVersion":1,"header":"","inputs":[{"name":"foo","type":null,"description":null,"default":null,"required":false}],"modules":null,"outputs":[{"name":"bar","description":null}],"providers":null,"requirements":null,"resources":[]}`,
+		},
+		{
+			name:     "version 2",
+			version:  2,
+			expected: `{"schemaVersion":2,"header":"","inputs":[{"name":"foo","type":null,"description":null,"default":null,"required":false,"references":[{"address":"output.bar","filename":"main.tf","line":1}],"unused":true}],"modules":null,"outputs":[{"name":"bar","description":null,"sources":["var.foo"]}],"providers":null,"requirements":null,"resources":null,"dataFlow":[{"from":"var.foo","to":"output.bar"}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := json.Marshal(Versioned(module, tt.version))
+
+			assert.Nil(err)
+			assert.Equal(tt.expected, string(actual))
+
+			assert.Equal(0, module.SchemaVersion)
+			assert.Len(module.Inputs[0].References, 1)
+			assert.True(module.Inputs[0].Unused)
+			assert.Len(module.Outputs[0].Sources, 1)
+			assert.Len(module.DataFlow, 1)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package site
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"path"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+)
+
+const (
+	markdownIndexTpl = `# Modules
+
+| Name | Description | Providers | Inputs | Outputs | Calls |
+|------|-------------|-----------|--------|---------|-------|
+{{- range . }}
+| [{{ cell .Name }}]({{ .Page }}) | {{ cell .Summary }} | {{ join .Providers }} | {{ len .Module.Inputs }} | {{ len .Module.Outputs }} | {{ range $i, $m := .Calls }}{{ if $i }}, {{ end }}[{{ cell $m.Name }}]({{ $m.Page }}){{ end }} |
+{{- end }}
+`
+
+	markdownCallsTpl = `{{ .Heading }} Local Modules
+
+{{ range .Calls -}}
+- [{{ .Name }}]({{ .Page }})
+{{ end -}}
+`
+
+	htmlCallsTpl = `<h2>Local Modules</h2>
+<ul>
+{{- range .Calls }}
+<li><a href="{{ .Page }}">{{ .Name }}</a></li>
+{{- end }}
+</ul>
+`
+
+	htmlIndexTpl = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Modules</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; line-height: 1.5; margin: 2em auto; max-width: 1200px; padding: 0 1em; color: #24292e; }
+table { border-collapse: collapse; width: 100%; }
+th, td { border: 1px solid #dfe2e5; padding: 6px 13px; text-align: left; vertical-align: top; }
+th { background: #f6f8fa; }
+</style>
+</head>
+<body>
+<h1>Modules</h1>
+<table>
+<thead><tr><th>Name</th><th>Description</th><th>Providers</th><th>Inputs</th><th>Outputs</th><th>Calls</th></tr></thead>
+<tbody>
+{{- range . }}
+<tr id="module_{{ .Path }}"><td><a href="{{ .Page }}">{{ .Name }}</a></td><td>{{ .Summary }}</td><td>{{ join .Providers }}</td><td>{{ len .Module.Inputs }}</td><td>{{ len .Module.Outputs }}</td><td>{{ range $i, $m := .Calls }}{{ if $i }}, {{ end }}<a href="{{ $m.Page }}">{{ $m.Name }}</a>{{ end }}</td></tr>
+{{- end }}
+</tbody>
+</table>
+</body>
+</html>`
+)
+
+var (
+	markdownIndex = texttemplate.Must(texttemplate.New("index").Funcs(texttemplate.FuncMap{
+		"cell": func(s string) string {
+			return strings.ReplaceAll(s, "|", "\\|")
+		},
+		"join": join,
+	}).Parse(markdownIndexTpl))
+
+	htmlIndex = htmltemplate.Must(htmltemplate.New("index").Funcs(htmltemplate.FuncMap{
+		"join": join,
+	}).Parse(htmlIndexTpl))
+
+	markdownCalls = texttemplate.Must(texttemplate.New("calls").Parse(markdownCallsTpl))
+
+	htmlCalls = htmltemplate.Must(htmltemplate.New("calls").Parse(htmlCallsTpl))
+)
+
+func join(items []string) string {
+	return strings.Join(items, ", ")
+}
+
+// renderIndex renders the index page of the catalog, listing all 'modules'.
+func renderIndex(f *Format, modules []*Module) (string, error) {
+	var buf bytes.Buffer
+	var err error
+	if f.Name == "html" {
+		err = htmlIndex.Execute(&buf, modules)
+	} else {
+		err = markdownIndex.Execute(&buf, modules)
+	}
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// call is a link to a local module called by the module of a page, relative to
+// the page.
+type call struct {
+	Name string
+	Page string
+}
+
+// addCalls adds the links to local modules called by 'm' to 'content' of its
+// page, at the end of Markdown or before the end of body of HTML.
+func addCalls(f *Format, m *Module, content string, settings *print.Settings) (string, error) {
+	if len(m.Calls) == 0 {
+		return content, nil
+	}
+
+	calls := make([]*call, 0, len(m.Calls))
+	for _, c := range m.Calls {
+		page, err := filepath.Rel(filepath.FromSlash(path.Dir(m.Page)), filepath.FromSlash(c.Page))
+		if err != nil {
+			return "", err
+		}
+		calls = append(calls, &call{Name: c.Name, Page: filepath.ToSlash(page)})
+	}
+
+	var buf bytes.Buffer
+	if f.Name == "html" {
+		if err := htmlCalls.Execute(&buf, struct{ Calls []*call }{calls}); err != nil {
+			return "", err
+		}
+		if i := strings.LastIndex(content, "</body>"); i >= 0 {
+			return content[:i] + buf.String() + content[i:], nil
+		}
+		return content + "\n" + buf.String(), nil
+	}
+
+	heading := strings.Repeat("#", settings.IndentLevel)
+	if err := markdownCalls.Execute(&buf, struct {
+		Heading string
+		Calls   []*call
+	}{heading, calls}); err != nil {
+		return "", err
+	}
+	if content = strings.TrimSuffix(content, "\n"); content != "" {
+		content += "\n\n"
+	}
+	return content + buf.String(), nil
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package site
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/internal/format"
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+)
+
+// Format represents the format of pages of the site.
+type Format struct {
+	Name      string // name of the format, i.e. 'markdown' or 'html'
+	Formatter string // formatter used to render pages of modules
+	Index     string // file name of index page
+	Page      string // file name of the page of each module
+}
+
+var formats = map[string]*Format{
+	"markdown": {Name: "markdown", Formatter: "markdown table", Index: "index.md", Page: "README.md"},
+	"html":     {Name: "html", Formatter: "html", Index: "index.html", Page: "module.html"},
+}
+
+// Formats returns names of the available formats of the site, sorted.
+func Formats() []string {
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Module represents a module of the catalog.
+type Module struct {
+	Path   string            // path of the module relative to root of the catalog, slash separated
+	Name   string            // display name of the module, i.e. its path or name of root directory
+	Page   string            // path of the page of the module relative to root of the site
+	Module *terraform.Module // loaded module
+	Calls  []*Module         // local modules called by the module, which are in the catalog
+}
+
+// Summary returns the first line of the module header, which isn't a heading
+// nor a label (e.g. 'Usage:'), or the first heading if there's none.
+func (m *Module) Summary() string {
+	var heading string
+	for _, line := range strings.Split(m.Module.Header, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") || strings.HasPrefix(line, "=") {
+			if heading == "" {
+				heading = strings.TrimSpace(strings.TrimLeft(line, "#="))
+			}
+			continue
+		}
+		if strings.HasSuffix(line, ":") {
+			continue
+		}
+		return line
+	}
+	return heading
+}
+
+// Providers returns names of unique providers used by the module, sorted.
+func (m *Module) Providers() []string {
+	seen := make(map[string]bool)
+	names := []string{}
+	for _, p := range m.Module.Providers {
+		if !seen[p.Name] {
+			seen[p.Name] = true
+			names = append(names, p.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Discover returns paths of all the directories in 'root', including 'root'
+// itself, which contain Terraform configuration, relative to 'root' and slash
+// separated. Hidden directories (e.g. '.terraform') are skipped.
+func Discover(root string) ([]string, error) {
+	paths := []string{}
+	err := filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if p != root && strings.HasPrefix(info.Name(), ".") {
+			return filepath.SkipDir
+		}
+		found, err := hasConfig(p)
+		if err != nil {
+			return err
+		}
+		if found {
+			rel, err := filepath.Rel(root, p)
+			if err != nil {
+				return err
+			}
+			paths = append(paths, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func hasConfig(dir string) (bool, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return false, err
+	}
+	for _, f := range files {
+		if !f.IsDir() && (strings.HasSuffix(f.Name(), ".tf") || strings.HasSuffix(f.Name(), ".tf.json")) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Load discovers and loads all the modules of catalog in 'root' with 'options',
+// and resolves their local module calls.
+func Load(root string, f *Format, options *terraform.Options) ([]*Module, error) {
+	loaded, err := LoadModules(root, options)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(loaded))
+	for p := range loaded {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	modules := make([]*Module, 0, len(paths))
+	index := make(map[string]*Module, len(paths))
+	for _, p := range paths {
+		name := p
+		if p == "." {
+			abs, err := filepath.Abs(root)
+			if err != nil {
+				return nil, err
+			}
+			name = filepath.Base(abs)
+		}
+
+		m := &Module{
+			Path:   p,
+			Name:   name,
+			Page:   path.Join(p, f.Page),
+			Module: loaded[p],
+		}
+		modules = append(modules, m)
+		index[p] = m
+	}
+
+	for _, m := range modules {
+		for _, call := range m.Module.ModuleCalls {
+			if !isLocal(call.Source) {
+				continue
+			}
+			if target, ok := index[path.Join(m.Path, call.Source)]; ok && target != m {
+				m.Calls = append(m.Calls, target)
+			}
+		}
+		sort.Slice(m.Calls, func(i, j int) bool { return m.Calls[i].Path < m.Calls[j].Path })
+	}
+	return modules, nil
+}
+
+// LoadModules discovers and loads all the modules in 'root' with 'options', keyed
+// by their path relative to 'root', slash separated. Output values are not loaded
+// as they belong to a single module.
+func LoadModules(root string, options *terraform.Options) (map[string]*terraform.Module, error) {
+	paths, err := Discover(root)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no Terraform modules found in '%s'", root)
+	}
+
+	modules := make(map[string]*terraform.Module, len(paths))
+	for _, p := range paths {
+		copy := *options
+		copy.Path = filepath.Join(root, filepath.FromSlash(p))
+		copy.OutputValues = false // output values belong to a single module
+		copy.OutputValuesPath = ""
+
+		module, err := terraform.LoadWithOptions(&copy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load module '%s': %s", p, err)
+		}
+		modules[p] = module
+	}
+	return modules, nil
+}
+
+// isLocal returns true if 'source' of a module call is a local path.
+func isLocal(source string) bool {
+	return strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../")
+}
+
+// Generate renders the page of all the modules of catalog in 'root' and the
+// index page of the catalog into 'outdir' in format 'name'. Paths of written
+// files are returned.
+func Generate(root string, outdir string, name string, options *terraform.Options, settings *print.Settings) ([]string, error) {
+	f, ok := formats[name]
+	if !ok {
+		return nil, fmt.Errorf("site format '%s' not found, available formats: %s", name, strings.Join(Formats(), ", "))
+	}
+
+	modules, err := Load(root, f, options)
+	if err != nil {
+		return nil, err
+	}
+
+	printer, err := format.Factory(f.Formatter, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0, len(modules)+1)
+	for _, m := range modules {
+		content, err := printer.Print(m.Module, settings)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render module '%s': %s", m.Path, err)
+		}
+		content, err = addCalls(f, m, content, settings)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render module '%s': %s", m.Path, err)
+		}
+		filename, err := write(outdir, m.Page, content)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, filename)
+	}
+
+	content, err := renderIndex(f, modules)
+	if err != nil {
+		return nil, err
+	}
+	filename, err := write(outdir, f.Index, content)
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, filename)
+
+	return files, nil
+}
+
+func write(outdir string, name string, content string) (string, error) {
+	filename := filepath.Join(outdir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return "", err
+	}
+	if !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	if err := ioutil.WriteFile(filename, []byte(content), 0644); err != nil {
+		return "", err
+	}
+	return filename, nil
+}
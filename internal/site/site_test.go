@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package site
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+)
+
+func TestDiscover(t *testing.T) {
+	assert := assert.New(t)
+
+	actual, err := Discover(filepath.Join("testdata", "catalog"))
+
+	assert.Nil(err)
+	assert.Equal([]string{".", "modules/app", "modules/network"}, actual)
+}
+
+func TestModuleSummary(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{
+			name:     "empty header",
+			header:   "",
+			expected: "",
+		},
+		{
+			name:     "first line after heading",
+			header:   "# Network\n\nCreates a VPC.\n\nMore details.",
+			expected: "Creates a VPC.",
+		},
+		{
+			name:     "only heading",
+			header:   "# App",
+			expected: "App",
+		},
+		{
+			name:     "asciidoc heading",
+			header:   "= App\n\nRuns the app.",
+			expected: "Runs the app.",
+		},
+		{
+			name:     "label after heading",
+			header:   "# App\n\nUsage:\n\n  Runs the app.",
+			expected: "Runs the app.",
+		},
+		{
+			name:     "only labels",
+			header:   "# App\n\nUsage:",
+			expected: "App",
+		},
+		{
+			name:     "no heading",
+			header:   "  Runs the app.\nSecond line.",
+			expected: "Runs the app.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			m := &Module{Module: &terraform.Module{Header: tt.header}}
+			assert.Equal(tt.expected, m.Summary())
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	assert := assert.New(t)
+
+	modules, err := Load(filepath.Join("testdata", "catalog"), formats["markdown"], terraform.NewOptions())
+	assert.Nil(err)
+	assert.Equal(3, len(modules))
+
+	root, app, network := modules[0], modules[1], modules[2]
+
+	assert.Equal("catalog", root.Name)
+	assert.Equal("README.md", root.Page)
+	assert.Equal([]*Module{app, network}, root.Calls)
+
+	assert.Equal("modules/app", app.Name)
+	assert.Equal("modules/app/README.md", app.Page)
+	assert.Equal([]*Module{network}, app.Calls)
+	assert.Equal([]string{"aws", "random"}, app.Providers())
+
+	assert.Empty(network.Calls)
+}
+
+func TestLoadModules(t *testing.T) {
+	assert := assert.New(t)
+
+	modules, err := LoadModules(filepath.Join("testdata", "catalog"), terraform.NewOptions())
+	assert.Nil(err)
+	assert.Equal(3, len(modules))
+	assert.Contains(modules, ".")
+	assert.Contains(modules, "modules/app")
+	assert.Contains(modules, "modules/network")
+
+	_, err = LoadModules(filepath.Join("testdata", "catalog", "modules", "app", "noop"), terraform.NewOptions())
+	assert.NotNil(err)
+}
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		expected []string
+		index    string
+		wantErr  bool
+	}{
+		{
+			name:     "generate markdown site",
+			format:   "markdown",
+			expected: []string{"README.md", "modules/app/README.md", "modules/network/README.md", "index.md"},
+			index:    "index.md",
+			wantErr:  false,
+		},
+		{
+			name:     "generate html site",
+			format:   "html",
+			expected: []string{"module.html", "modules/app/module.html", "modules/network/module.html", "index.html"},
+			index:    "index.html",
+			wantErr:  false,
+		},
+		{
+			name:     "unknown format",
+			format:   "pdf",
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			outdir := t.TempDir()
+			settings := print.DefaultSettings()
+
+			files, err := Generate(filepath.Join("testdata", "catalog"), outdir, tt.format, terraform.NewOptions(), settings)
+
+			if tt.wantErr {
+				assert.NotNil(err)
+				return
+			}
+			assert.Nil(err)
+
+			expected := make([]string, 0, len(tt.expected))
+			for _, f := range tt.expected {
+				expected = append(expected, filepath.Join(outdir, filepath.FromSlash(f)))
+			}
+			assert.Equal(expected, files)
+
+			golden, err := ioutil.ReadFile(filepath.Join("testdata", tt.index+".golden"))
+			assert.Nil(err)
+
+			actual, err := ioutil.ReadFile(filepath.Join(outdir, tt.index))
+			assert.Nil(err)
+			assert.Equal(string(golden), string(actual))
+		})
+	}
+}
+
+func TestGenerateCalls(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		pages    map[string]string
+		excluded string
+	}{
+		{
+			name:   "markdown pages",
+			format: "markdown",
+			pages: map[string]string{
+				"README.md":             "\n\n## Local Modules\n\n- [modules/app](modules/app/README.md)\n- [modules/network](modules/network/README.md)\n",
+				"modules/app/README.md": "\n\n## Local Modules\n\n- [modules/network](../network/README.md)\n",
+			},
+			excluded: "modules/network/README.md",
+		},
+		{
+			name:   "html pages",
+			format: "html",
+			pages: map[string]string{
+				"module.html":             "<h2>Local Modules</h2>\n<ul>\n<li><a href=\"modules/app/module.html\">modules/app</a></li>\n<li><a href=\"modules/network/module.html\">modules/network</a></li>\n</ul>\n</body>",
+				"modules/app/module.html": "<h2>Local Modules</h2>\n<ul>\n<li><a href=\"../network/module.html\">modules/network</a></li>\n</ul>\n</body>",
+			},
+			excluded: "modules/network/module.html",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			outdir := t.TempDir()
+
+			_, err := Generate(filepath.Join("testdata", "catalog"), outdir, tt.format, terraform.NewOptions(), print.DefaultSettings())
+			assert.Nil(err)
+
+			for page, expected := range tt.pages {
+				actual, err := ioutil.ReadFile(filepath.Join(outdir, filepath.FromSlash(page)))
+				assert.Nil(err)
+				assert.Contains(string(actual), expected, page)
+			}
+
+			actual, err := ioutil.ReadFile(filepath.Join(outdir, filepath.FromSlash(tt.excluded)))
+			assert.Nil(err)
+			assert.NotContains(string(actual), "Local Modules")
+		})
+	}
+}
@@ -0,0 +1,18 @@
+/**
+ * # Catalog
+ *
+ * Example catalog of modules.
+ */
+
+module "network" {
+  source = "./modules/network"
+}
+
+module "app" {
+  source = "./modules/app"
+}
+
+output "vpc_id" {
+  description = "ID of the VPC."
+  value       = module.network.vpc_id
+}
@@ -0,0 +1,26 @@
+/**
+ * # App
+ */
+
+variable "name" {
+  description = "Name of the app."
+  type        = string
+}
+
+module "network" {
+  source = "../network"
+}
+
+module "label" {
+  source  = "cloudposse/label/null"
+  version = "0.25.0"
+}
+
+resource "aws_instance" "this" {
+  ami           = "ami-123456"
+  instance_type = "t3.micro"
+}
+
+resource "random_id" "this" {
+  byte_length = 4
+}
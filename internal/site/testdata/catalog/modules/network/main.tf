@@ -0,0 +1,26 @@
+/**
+ * # Network
+ *
+ * Creates a VPC | subnets.
+ */
+
+variable "cidr" {
+  description = "CIDR block of the VPC."
+  type        = string
+}
+
+variable "tags" {
+  description = "Tags of resources."
+  type        = map(string)
+  default     = {}
+}
+
+resource "aws_vpc" "this" {
+  cidr_block = var.cidr
+  tags       = var.tags
+}
+
+output "vpc_id" {
+  description = "ID of the VPC."
+  value       = aws_vpc.this.id
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package template
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// LoadDir reads all the '.tmpl' files of 'dir' and returns their content
+// keyed by their name without extension, e.g. 'inputs.tmpl' is returned as
+// 'inputs', which can be used as 'print.Settings.Templates'.
+func LoadDir(dir string) (map[string]string, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := map[string]string{}
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != ".tmpl" {
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		templates[strings.TrimSuffix(f.Name(), ".tmpl")] = string(content)
+	}
+
+	if len(templates) == 0 {
+		return nil, fmt.Errorf("no template found in '%s'", dir)
+	}
+	return templates, nil
+}
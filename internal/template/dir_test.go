@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package template
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadDir(t *testing.T) {
+	tests := []struct {
+		name     string
+		dir      string
+		expected map[string]string
+		wantErr  bool
+	}{
+		{
+			name: "load templates from directory",
+			dir:  "templates",
+			expected: map[string]string{
+				"custom": "custom {{ .Module.Header }}\n",
+				"inputs": "{{- template \"custom\" . -}}\n",
+			},
+			wantErr: false,
+		},
+		{
+			name:     "load templates from directory without templates",
+			dir:      "table",
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "load templates from missing directory",
+			dir:      "noop",
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual, err := LoadDir(filepath.Join("testdata", tt.dir))
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
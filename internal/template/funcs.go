@@ -0,0 +1,238 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	gotemplate "text/template"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// Function represents a function available in templates.
+type Function struct {
+	Name        string
+	Signature   string
+	Description string
+}
+
+// functions is the documentation of all the functions available in templates,
+// including the built-in ones of plugin-sdk.
+var functions = []*Function{
+	{"anchor", "anchor(s string) string", "Returns the heading anchor (slug) of 's', e.g. 'Required Inputs' is 'required-inputs'."},
+	{"default", "default(d string, s string) string", "Returns 's' if it's not empty, otherwise 'd'."},
+	{"filterInputs", "filterInputs(by string, value any, inputs []Input) []Input", "Returns the inputs whose 'by' matches 'value', where 'by' is 'required' (bool), 'type' (string) or 'prefix' of name (string)."},
+	{"groupBy", "groupBy(field string, items []any) map[string][]any", "Groups 'items' by the value of their 'field', e.g. 'Type' or 'Required'. Groups are iterated in the order of their keys."},
+	{"indent", "indent(level int, char string) string", "Returns 'char' repeated 'level' plus configured indentation times, e.g. to generate headers."},
+	{"indentLines", "indentLines(n int, s string) string", "Indents all the non-empty lines of 's' with 'n' spaces."},
+	{"link", "link(text string, target string) string", "Returns a Markdown link to 'target' with 'text'."},
+	{"name", "name(s string) string", "Returns 's' escaped to be used as name of an item, if escaping is enabled."},
+	{"readFile", "readFile(path string) string", "Returns the content of the file at 'path', relative to the path of the module."},
+	{"sanitizeAsciidocTbl", "sanitizeAsciidocTbl(s string) string", "Sanitizes 's' to be used in an AsciiDoc table cell."},
+	{"sanitizeDoc", "sanitizeDoc(s string) string", "Sanitizes 's' to be used in a Markdown document."},
+	{"sanitizeHeader", "sanitizeHeader(s string) string", "Sanitizes 's' to be used as module header."},
+	{"sanitizeRST", "sanitizeRST(s string) string", "Sanitizes 's' to be used in a reStructuredText document or table cell."},
+	{"sanitizeTbl", "sanitizeTbl(s string) string", "Sanitizes 's' to be used in a Markdown table cell."},
+	{"ternary", "ternary(condition any, t string, f string) string", "Returns 't' if 'condition' is not empty, zero or false, otherwise 'f'."},
+	{"toHCL", "toHCL(v any) string", "Returns 'v' in HCL, e.g. default value of an input."},
+	{"toJSON", "toJSON(v any) string", "Returns 'v' in JSON."},
+	{"toYAML", "toYAML(v any) string", "Returns 'v' in YAML."},
+	{"tostring", "tostring(s String) string", "Converts description of an item to string."},
+	{"trim", "trim(cut string, s string) string", "Removes all leading and trailing characters of 'cut' from 's'."},
+	{"trimLeft", "trimLeft(cut string, s string) string", "Removes all leading characters of 'cut' from 's'."},
+	{"trimPrefix", "trimPrefix(prefix string, s string) string", "Removes leading 'prefix' from 's'."},
+	{"trimRight", "trimRight(cut string, s string) string", "Removes all trailing characters of 'cut' from 's'."},
+	{"trimSuffix", "trimSuffix(suffix string, s string) string", "Removes trailing 'suffix' from 's'."},
+	{"valueOf", "valueOf(item Input|Output) string", "Returns default value of an input or value of an output, in the configured value format."},
+}
+
+// Functions returns all the functions available in templates, sorted by name.
+// Formatters may add more functions to their own templates.
+func Functions() []*Function {
+	return functions
+}
+
+// builtinFuncs returns the functions added to all the templates, on top of
+// the built-in functions of plugin-sdk. Files are read relative to 'path',
+// which is set to the path of the module when it's rendered.
+func builtinFuncs(settings *print.Settings, path *string) gotemplate.FuncMap {
+	return gotemplate.FuncMap{
+		"tostring": func(s types.String) string {
+			return string(s)
+		},
+		"valueOf": func(v valuer) string {
+			if settings.ValueFormat == "hcl" {
+				return v.GetHCLValue()
+			}
+			return v.GetValue()
+		},
+		"sanitizeHeader": func(s string) string {
+			copy := *settings
+			copy.EscapePipe = false
+			s = sanitizeItemForDocument(s, &copy)
+			return s
+		},
+		"sanitizeDoc": func(s string) string {
+			return sanitizeItemForDocument(s, settings)
+		},
+		"sanitizeTbl": func(s string) string {
+			copy := *settings
+			copy.EscapePipe = true
+			s = sanitizeItemForTable(s, &copy)
+			return s
+		},
+		"sanitizeAsciidocTbl": func(s string) string {
+			copy := *settings
+			copy.EscapePipe = true
+			s = sanitizeItemForAsciidocTable(s, &copy)
+			return s
+		},
+		"sanitizeRST": func(s string) string {
+			return sanitizeItemForRST(s)
+		},
+		"toHCL":        types.HCL,
+		"toJSON":       toJSON,
+		"toYAML":       toYAML,
+		"indentLines":  indentLines,
+		"anchor":       anchor,
+		"link":         link,
+		"filterInputs": filterInputs,
+		"groupBy":      groupBy,
+		"readFile": func(name string) (string, error) {
+			return readFile(*path, name)
+		},
+	}
+}
+
+func toJSON(v interface{}) (string, error) {
+	if value, ok := v.(types.Value); ok {
+		v = value.Raw()
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func toYAML(v interface{}) (string, error) {
+	if value, ok := v.(types.Value); ok {
+		v = value.Raw()
+	}
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func indentLines(n int, s string) string {
+	prefix := strings.Repeat(" ", n)
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// anchor returns the anchor of heading 's' the same way GitHub does, i.e.
+// lower case letters, digits, '-' and '_' are kept, spaces are replaced by
+// '-' and the rest are removed.
+func anchor(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(s)) {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), r == '-', r == '_':
+			b.WriteRune(r)
+		case r == ' ':
+			b.WriteRune('-')
+		}
+	}
+	return b.String()
+}
+
+func link(text string, target string) string {
+	return fmt.Sprintf("[%s](%s)", text, target)
+}
+
+func filterInputs(by string, value interface{}, inputs []*terraform.Input) ([]*terraform.Input, error) {
+	filtered := []*terraform.Input{}
+	for _, i := range inputs {
+		var match bool
+		switch by {
+		case "required":
+			required, ok := value.(bool)
+			if !ok {
+				return nil, fmt.Errorf("value of 'required' filter must be bool, got %T", value)
+			}
+			match = i.Required == required
+		case "type":
+			match = string(i.Type) == fmt.Sprint(value)
+		case "prefix":
+			match = strings.HasPrefix(i.Name, fmt.Sprint(value))
+		default:
+			return nil, fmt.Errorf("'%s' is not a valid filter, available filters: required, type, prefix", by)
+		}
+		if match {
+			filtered = append(filtered, i)
+		}
+	}
+	return filtered, nil
+}
+
+func groupBy(field string, items interface{}) (map[string][]interface{}, error) {
+	list := reflect.ValueOf(items)
+	if list.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("groupBy expects a list, got %T", items)
+	}
+	groups := map[string][]interface{}{}
+	for i := 0; i < list.Len(); i++ {
+		item := list.Index(i)
+		el := reflect.Indirect(item)
+		if el.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("groupBy expects a list of objects, got %s", el.Type())
+		}
+		f := el.FieldByName(field)
+		if !f.IsValid() {
+			return nil, fmt.Errorf("'%s' is not a field of %s", field, el.Type())
+		}
+		key := fmt.Sprint(f.Interface())
+		groups[key] = append(groups[key], item.Interface())
+	}
+	return groups, nil
+}
+
+// readFile returns the content of file 'name' relative to 'path'.
+func readFile(path string, name string) (string, error) {
+	content, err := ioutil.ReadFile(filepath.Join(path, name))
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
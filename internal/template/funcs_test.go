@@ -0,0 +1,359 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package template
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+func TestFunctions(t *testing.T) {
+	assert := assert.New(t)
+
+	tpl, err := New(print.DefaultSettings(), nil)
+	assert.Nil(err)
+	funcs := tpl.Funcs()
+	names := []string{}
+	for _, f := range Functions() {
+		names = append(names, f.Name)
+		assert.Contains(funcs, f.Name)
+		assert.NotEmpty(f.Signature)
+		assert.NotEmpty(f.Description)
+	}
+	assert.Equal(len(funcs), len(names))
+	assert.True(sort.StringsAreSorted(names))
+}
+
+func TestFuncToJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{
+			name:     "toJSON of string",
+			value:    "foo",
+			expected: `"foo"`,
+		},
+		{
+			name:     "toJSON of value",
+			value:    types.ValueOf(map[string]interface{}{"a": 1, "b": []interface{}{"c"}}),
+			expected: `{"a":1,"b":["c"]}`,
+		},
+		{
+			name:     "toJSON of nil",
+			value:    types.ValueOf(nil),
+			expected: `null`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual, err := toJSON(tt.value)
+			assert.Nil(err)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestFuncToYAML(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{
+			name:     "toYAML of string",
+			value:    "foo",
+			expected: "foo",
+		},
+		{
+			name:     "toYAML of value",
+			value:    types.ValueOf(map[string]interface{}{"a": 1, "b": []interface{}{"c"}}),
+			expected: "a: 1\nb:\n  - c",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual, err := toYAML(tt.value)
+			assert.Nil(err)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestFuncIndentLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		value    string
+		expected string
+	}{
+		{
+			name:     "indentLines of single line",
+			n:        2,
+			value:    "foo",
+			expected: "  foo",
+		},
+		{
+			name:     "indentLines of multiple lines",
+			n:        4,
+			value:    "foo\n\nbar\n",
+			expected: "    foo\n\n    bar\n",
+		},
+		{
+			name:     "indentLines with zero",
+			n:        0,
+			value:    "foo\nbar",
+			expected: "foo\nbar",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, indentLines(tt.n, tt.value))
+		})
+	}
+}
+
+func TestFuncAnchor(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{
+			name:     "anchor of heading",
+			value:    "Required Inputs",
+			expected: "required-inputs",
+		},
+		{
+			name:     "anchor of heading with punctuation",
+			value:    " Input: foo_bar (optional)! ",
+			expected: "input-foo_bar-optional",
+		},
+		{
+			name:     "anchor of heading with dash and digits",
+			value:    "input-1",
+			expected: "input-1",
+		},
+		{
+			name:     "anchor of heading with non-ascii letters",
+			value:    "Übersicht",
+			expected: "übersicht",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, anchor(tt.value))
+		})
+	}
+}
+
+func TestFuncLink(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("[foo](#input_foo)", link("foo", "#input_foo"))
+}
+
+func TestFuncFilterInputs(t *testing.T) {
+	inputs := []*terraform.Input{
+		{Name: "foo", Type: types.String("string"), Required: true},
+		{Name: "foo_bar", Type: types.String("number"), Required: false},
+		{Name: "bar", Type: types.String("string"), Required: false},
+	}
+	tests := []struct {
+		name     string
+		by       string
+		value    interface{}
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "filterInputs by required",
+			by:       "required",
+			value:    true,
+			expected: []string{"foo"},
+			wantErr:  false,
+		},
+		{
+			name:     "filterInputs by optional",
+			by:       "required",
+			value:    false,
+			expected: []string{"foo_bar", "bar"},
+			wantErr:  false,
+		},
+		{
+			name:     "filterInputs by required with invalid value",
+			by:       "required",
+			value:    "yes",
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "filterInputs by type",
+			by:       "type",
+			value:    "string",
+			expected: []string{"foo", "bar"},
+			wantErr:  false,
+		},
+		{
+			name:     "filterInputs by prefix",
+			by:       "prefix",
+			value:    "foo",
+			expected: []string{"foo", "foo_bar"},
+			wantErr:  false,
+		},
+		{
+			name:     "filterInputs by prefix without match",
+			by:       "prefix",
+			value:    "baz",
+			expected: []string{},
+			wantErr:  false,
+		},
+		{
+			name:     "filterInputs by unknown",
+			by:       "unknown",
+			value:    "foo",
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual, err := filterInputs(tt.by, tt.value, inputs)
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+				names := []string{}
+				for _, i := range actual {
+					names = append(names, i.Name)
+				}
+				assert.Equal(tt.expected, names)
+			}
+		})
+	}
+}
+
+func TestFuncGroupBy(t *testing.T) {
+	inputs := []*terraform.Input{
+		{Name: "foo", Type: types.String("string"), Required: true},
+		{Name: "bar", Type: types.String("number"), Required: false},
+		{Name: "baz", Type: types.String("string"), Required: false},
+	}
+	tests := []struct {
+		name     string
+		field    string
+		items    interface{}
+		expected map[string][]string
+		wantErr  bool
+	}{
+		{
+			name:  "groupBy type",
+			field: "Type",
+			items: inputs,
+			expected: map[string][]string{
+				"number": {"bar"},
+				"string": {"foo", "baz"},
+			},
+			wantErr: false,
+		},
+		{
+			name:  "groupBy required",
+			field: "Required",
+			items: inputs,
+			expected: map[string][]string{
+				"false": {"bar", "baz"},
+				"true":  {"foo"},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "groupBy unknown field",
+			field:    "Unknown",
+			items:    inputs,
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "groupBy not a list",
+			field:    "Type",
+			items:    inputs[0],
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "groupBy list of non objects",
+			field:    "Type",
+			items:    []string{"foo"},
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual, err := groupBy(tt.field, tt.items)
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+				groups := map[string][]string{}
+				for k, items := range actual {
+					for _, i := range items {
+						groups[k] = append(groups[k], i.(*terraform.Input).Name)
+					}
+				}
+				assert.Equal(tt.expected, groups)
+			}
+		})
+	}
+}
+
+func TestFuncReadFile(t *testing.T) {
+	assert := assert.New(t)
+
+	actual, err := readFile("testdata", "templates/custom.tmpl")
+	assert.Nil(err)
+	assert.Equal("custom {{ .Module.Header }}\n", actual)
+
+	_, err = readFile("testdata", "noop")
+	assert.NotNil(err)
+}
+
+func TestFuncReadFileModulePath(t *testing.T) {
+	assert := assert.New(t)
+
+	module, err := testutil.GetModule(terraform.NewOptions())
+	assert.Nil(err)
+
+	expected, err := ioutil.ReadFile(filepath.Join(module.Path(), "doc.txt"))
+	assert.Nil(err)
+
+	tpl, err := New(print.DefaultSettings(), nil, &Item{Name: "all", Text: `{{ readFile "doc.txt" }}`})
+	assert.Nil(err)
+	actual, err := tpl.Render(module)
+	assert.Nil(err)
+	assert.Equal(strings.TrimSpace(string(expected)), strings.TrimSpace(actual))
+}
@@ -105,6 +105,79 @@ func sanitizeItemForAsciidocTable(s string, settings *print.Settings) string {
 	return result
 }
 
+// sanitizeItemForRST converts passed 'string' to suitable reStructuredText representation
+// for a document or a table. (including illegal characters, code blocks etc)
+func sanitizeItemForRST(s string) string {
+	if s == "" {
+		return "n/a"
+	}
+	result := processSegments(
+		s,
+		"```",
+		func(segment string) string {
+			return processSegments(
+				segment,
+				"`",
+				escapeRSTCharacters,
+				func(segment string) string {
+					return fmt.Sprintf("``%s``", segment)
+				},
+			)
+		},
+		func(segment string) string {
+			var language string
+			if i := strings.Index(segment, "\n"); i != -1 {
+				language = strings.TrimSpace(segment[:i])
+				segment = segment[i+1:]
+			}
+			segment = strings.TrimRight(segment, " \r\n")
+			segment = executePerLine(segment, func(line string) string {
+				if line == "" {
+					return line
+				}
+				return "   " + line
+			})
+			directive := strings.TrimSpace(".. code-block:: " + language)
+			return fmt.Sprintf("\n\n%s\n\n%s\n\n", directive, segment)
+		},
+	)
+	return result
+}
+
+var (
+	rstBullet   = regexp.MustCompile(`^\s*\* `)
+	rstEmphasis = regexp.MustCompile(`\*\*[^\s*](?:[^*]*[^\s*])?\*\*|\*[^\s*](?:[^*]*[^\s*])?\*`)
+	rstRefEnd   = regexp.MustCompile(`(\w)_(\W|$)`)
+)
+
+// escapeRSTCharacters escapes characters which have special meaning in reStructuredText
+// into their corresponding literal, i.e. backslash, substitution, trailing underscore of
+// references and asterisk which isn't a bullet or part of an emphasis.
+func escapeRSTCharacters(s string) string {
+	escape := func(s string) string {
+		s = strings.Replace(s, "\\", "\\\\", -1)
+		s = strings.Replace(s, "*", "\\*", -1)
+		s = strings.Replace(s, "|", "\\|", -1)
+		s = rstRefEnd.ReplaceAllString(s, "$1\\_$2")
+		return s
+	}
+	return executePerLine(s, func(line string) string {
+		bullet := rstBullet.FindString(line)
+		line = line[len(bullet):]
+
+		var b strings.Builder
+		b.WriteString(bullet)
+		last := 0
+		for _, loc := range rstEmphasis.FindAllStringIndex(line, -1) {
+			b.WriteString(escape(line[last:loc[0]]))
+			b.WriteString(line[loc[0]:loc[1]])
+			last = loc[1]
+		}
+		b.WriteString(escape(line[last:]))
+		return b.String()
+	})
+}
+
 // convertMultiLineText converts a multi-line text into a suitable Markdown representation.
 func convertMultiLineText(s string, isTable bool) string {
 	if isTable {
@@ -590,3 +590,78 @@ func TestGenerateIndentation(t *testing.T) {
 		})
 	}
 }
+
+func TestSanitizeItemForRST(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+	}{
+		{
+			name:     "sanitize rst item empty",
+			filename: "empty",
+		},
+		{
+			name:     "sanitize rst item complex",
+			filename: "complex",
+		},
+		{
+			name:     "sanitize rst item codeblock",
+			filename: "codeblock",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			bytes, err := ioutil.ReadFile(filepath.Join("testdata", "table", tt.filename+".golden"))
+			assert.Nil(err)
+
+			actual := sanitizeItemForRST(string(bytes))
+
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", "table", tt.filename+".rst.expected"))
+			assert.Nil(err)
+
+			assert.Equal(string(expected), actual)
+		})
+	}
+}
+
+func TestEscapeRSTCharacters(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "plain text",
+			input:    "lorem ipsum",
+			expected: "lorem ipsum",
+		},
+		{
+			name:     "substitution and backslash",
+			input:    `a | b \ c`,
+			expected: `a \| b \\ c`,
+		},
+		{
+			name:     "reference end",
+			input:    "foo_ bar_baz qux_",
+			expected: `foo\_ bar_baz qux\_`,
+		},
+		{
+			name:     "stray asterisk",
+			input:    "2 * 3",
+			expected: `2 \* 3`,
+		},
+		{
+			name:     "emphasis and bullet",
+			input:    "* item with **strong** and *em*",
+			expected: "* item with **strong** and *em*",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, escapeRSTCharacters(tt.input))
+		})
+	}
+}
@@ -11,12 +11,12 @@ the root directory of this source tree.
 package template
 
 import (
+	"sort"
 	gotemplate "text/template"
 
 	templatesdk "github.com/terraform-docs/plugin-sdk/template"
 	"github.com/terraform-docs/terraform-docs/internal/print"
 	"github.com/terraform-docs/terraform-docs/internal/terraform"
-	"github.com/terraform-docs/terraform-docs/internal/types"
 )
 
 // Item represents a named templated which can reference
@@ -32,47 +32,60 @@ type Item struct {
 type Template struct {
 	engine   *templatesdk.Template
 	settings *print.Settings
+	path     *string // path of the module being rendered
 }
 
-// New returns new instance of Template.
-func New(settings *print.Settings, items ...*Item) *Template {
+// valuer is implemented by items which have a value, i.e. default value of
+// an input or value of an output, and it can be represented in JSON or HCL.
+type valuer interface {
+	GetValue() string
+	GetHCLValue() string
+}
+
+// New returns new instance of Template with 'funcs' added to the built-in
+// functions. Items with the same name as the ones in 'settings.Templates' are
+// overridden by them, and the rest of 'settings.Templates' are added as new
+// named templates. An error is returned if any of 'settings.Templates' can't
+// be parsed.
+func New(settings *print.Settings, funcs gotemplate.FuncMap, items ...*Item) (*Template, error) {
 	ii := []*templatesdk.Item{}
 	for _, v := range items {
-		ii = append(ii, &templatesdk.Item{Name: v.Name, Text: v.Text})
+		text := v.Text
+		if t, ok := settings.Templates[v.Name]; ok {
+			text = t
+		}
+		ii = append(ii, &templatesdk.Item{Name: v.Name, Text: text})
 	}
 
+	names := []string{}
+	for name := range settings.Templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !hasItem(items, name) {
+			ii = append(ii, &templatesdk.Item{Name: name, Text: settings.Templates[name]})
+		}
+	}
+
+	path := ""
 	engine := templatesdk.New(settings.Convert(), ii...)
-	engine.CustomFunc(gotemplate.FuncMap{
-		"tostring": func(s types.String) string {
-			return string(s)
-		},
-		"sanitizeHeader": func(s string) string {
-			copy := *settings
-			copy.EscapePipe = false
-			s = sanitizeItemForDocument(s, &copy)
-			return s
-		},
-		"sanitizeDoc": func(s string) string {
-			return sanitizeItemForDocument(s, settings)
-		},
-		"sanitizeTbl": func(s string) string {
-			copy := *settings
-			copy.EscapePipe = true
-			s = sanitizeItemForTable(s, &copy)
-			return s
-		},
-		"sanitizeAsciidocTbl": func(s string) string {
-			copy := *settings
-			copy.EscapePipe = true
-			s = sanitizeItemForAsciidocTable(s, &copy)
-			return s
-		},
-	})
+	engine.CustomFunc(builtinFuncs(settings, &path))
+	engine.CustomFunc(funcs)
+
+	// engine panics on rendering if any of the templates can't be parsed, which
+	// can only happen with the templates provided by users
+	for _, name := range names {
+		if _, err := gotemplate.New(name).Funcs(engine.Funcs()).Parse(settings.Templates[name]); err != nil {
+			return nil, err
+		}
+	}
 
 	return &Template{
 		engine:   engine,
 		settings: settings,
-	}
+		path:     &path,
+	}, nil
 }
 
 // Funcs return available template out of the box and custom functions.
@@ -80,13 +93,17 @@ func (t Template) Funcs() gotemplate.FuncMap {
 	return t.engine.Funcs()
 }
 
-// CustomFunc adds new custom functions to the template
-// if functions with the same names didn't exist.
-func (t Template) CustomFunc(funcs gotemplate.FuncMap) {
-	t.engine.CustomFunc(funcs)
-}
-
 // Render template with given Module struct.
 func (t Template) Render(module *terraform.Module) (string, error) {
+	*t.path = module.Path()
 	return t.engine.Render(module)
 }
+
+func hasItem(items []*Item, name string) bool {
+	for _, item := range items {
+		if item.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -69,8 +69,8 @@ func TestTemplateRender(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
-			tpl := New(print.DefaultSettings(), tt.items...)
-			tpl.CustomFunc(customFuncs)
+			tpl, err := New(print.DefaultSettings(), customFuncs, tt.items...)
+			assert.Nil(err)
 			rendered, err := tpl.Render(module)
 			if tt.wantErr {
 				assert.NotNil(err)
@@ -82,6 +82,82 @@ func TestTemplateRender(t *testing.T) {
 	}
 }
 
+func TestTemplateRenderOverrides(t *testing.T) {
+	items := []*Item{
+		{
+			Name: "all",
+			Text: `{{- template "section" . -}}`,
+		}, {
+			Name: "section",
+			Text: `{{- .Module.Header -}}`,
+		},
+	}
+	module := &terraform.Module{
+		Header: "sample header",
+	}
+	tests := []struct {
+		name      string
+		templates map[string]string
+		expected  string
+		wantErr   string
+	}{
+		{
+			name:      "template render without overrides",
+			templates: map[string]string{},
+			expected:  "sample header",
+		},
+		{
+			name: "template render with overridden item",
+			templates: map[string]string{
+				"section": `{{- upper .Module.Header -}}`,
+			},
+			expected: "SAMPLE HEADER",
+		},
+		{
+			name: "template render with overridden item referencing new item",
+			templates: map[string]string{
+				"section": `{{- template "custom" . -}}`,
+				"custom":  `custom {{ .Module.Header | sanitizeDoc }}`,
+			},
+			expected: "custom sample header\n",
+		},
+		{
+			name: "template render with malformed item",
+			templates: map[string]string{
+				"section": `{{- if -}}`,
+			},
+			expected: "",
+			wantErr:  "template: section:1: missing value for if",
+		},
+		{
+			name: "template render with unknown function",
+			templates: map[string]string{
+				"section": `{{- unknown .Module.Header -}}`,
+			},
+			expected: "",
+			wantErr:  "template: section:1: function \"unknown\" not defined",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			settings := print.DefaultSettings()
+			settings.Templates = tt.templates
+			tpl, err := New(settings, gotemplate.FuncMap{
+				"upper": strings.ToUpper,
+			}, items...)
+			if tt.wantErr != "" {
+				assert.EqualError(err, tt.wantErr)
+				return
+			}
+			assert.Nil(err)
+			rendered, err := tpl.Render(module)
+			assert.Nil(err)
+			assert.Equal(tt.expected, rendered)
+		})
+	}
+}
+
 func TestBuiltinFunc(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -489,7 +565,8 @@ func TestBuiltinFunc(t *testing.T) {
 			settings := print.DefaultSettings()
 			settings.EscapeCharacters = tt.escapeChar
 			settings.EscapePipe = tt.escapePipe
-			tmpl := New(settings)
+			tmpl, err := New(settings, nil)
+			assert.Nil(err)
 			funcs := tmpl.Funcs()
 
 			fn, ok := funcs[tt.funcName]
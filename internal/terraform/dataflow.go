@@ -0,0 +1,378 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// DataFlow represents a reference from an item of the module to another one,
+// i.e. data flowing from 'From' to 'To'. Items are identified by their address,
+// e.g. 'var.region', 'local.name', 'provider.aws', 'aws_instance.this',
+// 'data.aws_ami.this', 'module.vpc' and 'output.id'.
+type DataFlow struct {
+	From string `json:"from" toml:"from" xml:"from" yaml:"from"`
+	To   string `json:"to" toml:"to" xml:"to" yaml:"to"`
+}
+
+type dataflowsSortedByAddress []*DataFlow
+
+func (a dataflowsSortedByAddress) Len() int      { return len(a) }
+func (a dataflowsSortedByAddress) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a dataflowsSortedByAddress) Less(i, j int) bool {
+	return a[i].From < a[j].From || (a[i].From == a[j].From && a[i].To < a[j].To)
+}
+
+// AffectedResources returns addresses of providers, resources, data sources and
+// module calls which are affected by 'input', directly or through other items.
+func (m *Module) AffectedResources(input string) []string {
+	affected := []string{}
+	for _, address := range m.affected("var." + input) {
+		if !strings.HasPrefix(address, "local.") && !strings.HasPrefix(address, "output.") {
+			affected = append(affected, address)
+		}
+	}
+	return affected
+}
+
+// AffectedOutputs returns names of outputs which are affected by 'input',
+// directly or through other items.
+func (m *Module) AffectedOutputs(input string) []string {
+	affected := []string{}
+	for _, address := range m.affected("var." + input) {
+		if strings.HasPrefix(address, "output.") {
+			affected = append(affected, strings.TrimPrefix(address, "output."))
+		}
+	}
+	return affected
+}
+
+// affected returns sorted addresses of all the items reachable from 'address'
+// in the data flow of the module.
+func (m *Module) affected(address string) []string {
+	next := make(map[string][]string)
+	for _, f := range m.DataFlow {
+		next[f.From] = append(next[f.From], f.To)
+	}
+	visited := map[string]bool{address: true}
+	queue := []string{address}
+	affected := []string{}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, to := range next[current] {
+			if !visited[to] {
+				visited[to] = true
+				queue = append(queue, to)
+				affected = append(affected, to)
+			}
+		}
+	}
+	sort.Strings(affected)
+	return affected
+}
+
+// items are the items declared in a module, keyed by their address, with the
+// bodies in which they reference other items. Variables have no body.
+type items map[string][]*hclsyntax.Body
+
+// parseItems returns the items declared in 'bodies' of the module. Each local
+// is an item of its own, with a body of its single attribute.
+func parseItems(bodies []*hclsyntax.Body) items {
+	declared := make(items)
+	for _, body := range bodies {
+		for _, block := range body.Blocks {
+			if block.Type == "locals" {
+				for name, attr := range block.Body.Attributes {
+					address := "local." + name
+					declared[address] = append(declared[address], &hclsyntax.Body{Attributes: hclsyntax.Attributes{name: attr}})
+				}
+				continue
+			}
+			address := blockAddress(block)
+			if address == "" {
+				continue
+			}
+			if block.Type == "variable" {
+				declared[address] = nil
+				continue
+			}
+			declared[address] = append(declared[address], block.Body)
+		}
+	}
+	return declared
+}
+
+// parseBodies returns the bodies of '.tf' files of the module at 'path', sorted
+// by name of the files. If the module has any '.tf.json' file, which isn't
+// parsed, its bodies are only partially known and nil is returned.
+func parseBodies(path string) ([]*hclsyntax.Body, error) {
+	if filenames, err := filepath.Glob(filepath.Join(path, "*.tf.json")); err != nil || len(filenames) > 0 {
+		return nil, err
+	}
+
+	filenames, err := filepath.Glob(filepath.Join(path, "*.tf"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(filenames)
+
+	parser := hclparse.NewParser()
+	bodies := []*hclsyntax.Body{}
+	for _, filename := range filenames {
+		file, diags := parser.ParseHCLFile(filename)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		if body, ok := file.Body.(*hclsyntax.Body); ok {
+			bodies = append(bodies, body)
+		}
+	}
+	return bodies, nil
+}
+
+// loadDataFlow returns the data flow of the module at 'path', based on the
+// references in the bodies of its locals, providers, resources, data sources,
+// module calls and outputs, and on the providers used by resources, data sources
+// and module calls. Only references to items declared in the module are
+// included. If the module has any '.tf.json' file, which isn't parsed, its data
+// flow is unknown and nil is returned.
+func loadDataFlow(path string) ([]*DataFlow, error) {
+	bodies, err := parseBodies(path)
+	if err != nil || bodies == nil {
+		return nil, err
+	}
+	declared := parseItems(bodies)
+
+	seen := make(map[DataFlow]bool)
+	dataflow := []*DataFlow{}
+	add := func(from string, to string) {
+		f := DataFlow{From: from, To: to}
+		if _, ok := declared[from]; !ok || from == to || seen[f] {
+			return
+		}
+		seen[f] = true
+		dataflow = append(dataflow, &f)
+	}
+	for to, bodies := range declared {
+		for _, body := range bodies {
+			for _, traversal := range bodyReferences(body) {
+				add(referenceAddress(traversal), to)
+			}
+		}
+	}
+	for _, body := range bodies {
+		for _, block := range body.Blocks {
+			for _, from := range blockProviders(block, declared) {
+				add(from, blockAddress(block))
+			}
+		}
+	}
+	sort.Sort(dataflowsSortedByAddress(dataflow))
+	return dataflow, nil
+}
+
+// blockProviders returns addresses of the providers used by resource, data
+// source or module call 'block'. These are the providers set in its 'provider'
+// (or 'providers' of module calls) meta-argument, or otherwise the default
+// configuration of the provider implied by type of resources and data sources,
+// and all the default configurations for module calls, which inherit them.
+func blockProviders(block *hclsyntax.Block, declared items) []string {
+	if blockAddress(block) == "" {
+		return nil
+	}
+	switch block.Type {
+	case "resource", "data":
+		if attr, ok := block.Body.Attributes["provider"]; ok {
+			return providerReferences(attr.Expr)
+		}
+		return []string{"provider." + strings.SplitN(block.Labels[0], "_", 2)[0]}
+	case "module":
+		if attr, ok := block.Body.Attributes["providers"]; ok {
+			return providerReferences(attr.Expr)
+		}
+		providers := []string{}
+		for address := range declared {
+			if strings.HasPrefix(address, "provider.") && strings.Count(address, ".") == 1 {
+				providers = append(providers, address)
+			}
+		}
+		sort.Strings(providers)
+		return providers
+	}
+	return nil
+}
+
+// providerReferences returns addresses of the providers referenced by 'expr',
+// e.g. 'provider.aws.east' for 'aws.east'. Only the values are considered in
+// 'providers' map of module calls, whose keys are names in the called module.
+func providerReferences(expr hclsyntax.Expression) []string {
+	exprs := []hclsyntax.Expression{expr}
+	if object, ok := expr.(*hclsyntax.ObjectConsExpr); ok {
+		exprs = exprs[:0]
+		for _, item := range object.Items {
+			exprs = append(exprs, item.ValueExpr)
+		}
+	}
+	providers := []string{}
+	for _, e := range exprs {
+		for _, traversal := range e.Variables() {
+			parts := referenceParts(traversal)
+			if len(parts) > 2 {
+				parts = parts[:2]
+			}
+			providers = append(providers, "provider."+strings.Join(parts, "."))
+		}
+	}
+	return providers
+}
+
+// loadOutputSources returns the sources of outputs of the module at 'path',
+// keyed by their name. Sources are the variables, resources, data sources and
+// outputs of module calls referenced in 'value' of the output, directly or
+// through locals, e.g. 'var.region', 'aws_instance.this' or 'module.vpc.id'.
+// If the module has any '.tf.json' file, which isn't parsed, the sources are
+// unknown and nil is returned.
+func loadOutputSources(path string) (map[string][]string, error) {
+	bodies, err := parseBodies(path)
+	if err != nil || bodies == nil {
+		return nil, err
+	}
+	declared := parseItems(bodies)
+
+	sources := make(map[string][]string)
+	for address, bodies := range declared {
+		if !strings.HasPrefix(address, "output.") {
+			continue
+		}
+		seen := make(map[string]bool)
+		list := []string{}
+		for _, body := range bodies {
+			if attr, ok := body.Attributes["value"]; ok {
+				list = append(list, declared.sources(attr.Expr.Variables(), seen)...)
+			}
+		}
+		sort.Strings(list)
+		sources[strings.TrimPrefix(address, "output.")] = list
+	}
+	return sources, nil
+}
+
+// sources returns the sources referenced by 'traversals', in which locals are
+// replaced by the sources they reference in turn. Sources and locals already
+// in 'seen' are skipped.
+func (declared items) sources(traversals []hcl.Traversal, seen map[string]bool) []string {
+	list := []string{}
+	for _, traversal := range traversals {
+		address := referenceAddress(traversal)
+		if _, ok := declared[address]; !ok {
+			continue
+		}
+		source := address
+		if strings.HasPrefix(address, "module.") {
+			if parts := referenceParts(traversal); len(parts) > 2 {
+				source = strings.Join(parts[:3], ".")
+			}
+		}
+		if seen[source] {
+			continue
+		}
+		seen[source] = true
+		if strings.HasPrefix(address, "local.") {
+			for _, body := range declared[address] {
+				list = append(list, declared.sources(bodyReferences(body), seen)...)
+			}
+			continue
+		}
+		list = append(list, source)
+	}
+	return list
+}
+
+// blockAddress returns address of the item declared by top-level 'block', or
+// an empty string if it doesn't declare any (e.g. 'terraform'). Providers are
+// addressed by their name and alias, if any, e.g. 'provider.aws.east'.
+func blockAddress(block *hclsyntax.Block) string {
+	switch {
+	case block.Type == "variable" && len(block.Labels) == 1:
+		return "var." + block.Labels[0]
+	case block.Type == "resource" && len(block.Labels) == 2:
+		return block.Labels[0] + "." + block.Labels[1]
+	case block.Type == "data" && len(block.Labels) == 2:
+		return "data." + block.Labels[0] + "." + block.Labels[1]
+	case block.Type == "module" && len(block.Labels) == 1:
+		return "module." + block.Labels[0]
+	case block.Type == "output" && len(block.Labels) == 1:
+		return "output." + block.Labels[0]
+	case block.Type == "provider" && len(block.Labels) == 1:
+		if alias, ok := block.Body.Attributes["alias"]; ok {
+			if value, diags := alias.Expr.Value(nil); !diags.HasErrors() && value.Type() == cty.String {
+				return "provider." + block.Labels[0] + "." + value.AsString()
+			}
+		}
+		return "provider." + block.Labels[0]
+	}
+	return ""
+}
+
+// bodyReferences returns all the references in attributes of 'body' and of
+// its nested blocks.
+func bodyReferences(body *hclsyntax.Body) []hcl.Traversal {
+	names := make([]string, 0, len(body.Attributes))
+	for name := range body.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	traversals := []hcl.Traversal{}
+	for _, name := range names {
+		traversals = append(traversals, body.Attributes[name].Expr.Variables()...)
+	}
+	for _, block := range body.Blocks {
+		traversals = append(traversals, bodyReferences(block.Body)...)
+	}
+	return traversals
+}
+
+// referenceAddress returns address of the item referenced by 'traversal',
+// e.g. 'module.vpc' for 'module.vpc.id'.
+func referenceAddress(traversal hcl.Traversal) string {
+	parts := referenceParts(traversal)
+	size := 2
+	if parts[0] == "data" {
+		size = 3
+	}
+	if len(parts) < size {
+		return ""
+	}
+	return strings.Join(parts[:size], ".")
+}
+
+// referenceParts returns the root name and the attribute names of 'traversal'
+// up to its first index, e.g. 'aws_instance', 'this' for 'aws_instance.this[0].id'.
+func referenceParts(traversal hcl.Traversal) []string {
+	parts := []string{traversal.RootName()}
+	for _, step := range traversal[1:] {
+		attr, ok := step.(hcl.TraverseAttr)
+		if !ok {
+			break
+		}
+		parts = append(parts, attr.Name)
+	}
+	return parts
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadDataFlow(t *testing.T) {
+	assert := assert.New(t)
+
+	dataflow, err := loadDataFlow(filepath.Join("testdata", "dataflow"))
+	assert.Nil(err)
+	assert.Equal([]*DataFlow{
+		{From: "aws_instance.this", To: "module.dns"},
+		{From: "aws_instance.this", To: "output.id"},
+		{From: "data.aws_ami.this", To: "aws_instance.this"},
+		{From: "local.tags", To: "aws_instance.this"},
+		{From: "local.tags", To: "output.tags"},
+		{From: "module.dns", To: "output.fqdn"},
+		{From: "provider.aws", To: "aws_instance.this"},
+		{From: "provider.aws", To: "data.aws_ami.this"},
+		{From: "provider.aws", To: "module.dns"},
+		{From: "var.name", To: "local.tags"},
+		{From: "var.name", To: "module.dns"},
+		{From: "var.region", To: "provider.aws"},
+		{From: "var.region", To: "provider.aws.dns"},
+		{From: "var.tags", To: "local.tags"},
+		{From: "var.unused", To: "output.tags"},
+	}, dataflow)
+}
+
+func TestLoadDataFlowProviders(t *testing.T) {
+	assert := assert.New(t)
+
+	dataflow, err := loadDataFlow(filepath.Join("testdata", "dataflow-providers"))
+	assert.Nil(err)
+	assert.Equal([]*DataFlow{
+		{From: "aws_s3_bucket.east", To: "output.bucket"},
+		{From: "provider.aws", To: "aws_s3_bucket.default"},
+		{From: "provider.aws", To: "module.logs"},
+		{From: "provider.aws.east", To: "aws_s3_bucket.east"},
+		{From: "provider.aws.east", To: "data.aws_region.east"},
+		{From: "provider.aws.east", To: "module.replica"},
+		{From: "var.east_region", To: "provider.aws.east"},
+		{From: "var.region", To: "provider.aws"},
+	}, dataflow)
+
+	module := &Module{DataFlow: dataflow}
+	assert.Equal([]string{"aws_s3_bucket.east", "data.aws_region.east", "module.replica", "provider.aws.east"}, module.AffectedResources("east_region"))
+	assert.Equal([]string{"bucket"}, module.AffectedOutputs("east_region"))
+	assert.Equal([]string{"aws_s3_bucket.default", "module.logs", "provider.aws"}, module.AffectedResources("region"))
+}
+
+func TestLoadDataFlowJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	dataflow, err := loadDataFlow(filepath.Join("testdata", "references-json"))
+	assert.Nil(err)
+	assert.Nil(dataflow)
+}
+
+func TestLoadDataFlowNoModule(t *testing.T) {
+	assert := assert.New(t)
+
+	dataflow, err := loadDataFlow(filepath.Join("testdata", "non-existent"))
+	assert.Nil(err)
+	assert.Equal([]*DataFlow{}, dataflow)
+}
+
+func TestLoadOutputSources(t *testing.T) {
+	assert := assert.New(t)
+
+	sources, err := loadOutputSources(filepath.Join("testdata", "dataflow"))
+	assert.Nil(err)
+	assert.Equal(map[string][]string{
+		"fqdn": {"module.dns.fqdn"},
+		"id":   {"aws_instance.this"},
+		"tags": {"var.name", "var.tags", "var.unused"},
+	}, sources)
+}
+
+func TestLoadOutputSourcesJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	sources, err := loadOutputSources(filepath.Join("testdata", "references-json"))
+	assert.Nil(err)
+	assert.Nil(sources)
+}
+
+func TestOutputSourcesKnown(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{
+			name:     "sources of outputs known",
+			path:     "dataflow",
+			expected: true,
+		},
+		{
+			name:     "sources of outputs unknown",
+			path:     "references-json",
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			options := NewOptions()
+			options.Path = filepath.Join("testdata", tt.path)
+			options.OutputSources = true
+
+			module, err := LoadWithOptions(options)
+			assert.Nil(err)
+			assert.Equal(tt.expected, module.OutputSourcesKnown())
+		})
+	}
+}
+
+func TestAffected(t *testing.T) {
+	module := &Module{
+		DataFlow: []*DataFlow{
+			{From: "aws_instance.this", To: "module.dns"},
+			{From: "aws_instance.this", To: "output.id"},
+			{From: "data.aws_ami.this", To: "aws_instance.this"},
+			{From: "local.tags", To: "aws_instance.this"},
+			{From: "module.dns", To: "output.fqdn"},
+			{From: "var.name", To: "local.tags"},
+			{From: "var.name", To: "module.dns"},
+			{From: "var.region", To: "provider.aws"},
+			{From: "var.region", To: "provider.aws.dns"},
+			{From: "var.tags", To: "local.tags"},
+		},
+	}
+	tests := []struct {
+		name      string
+		input     string
+		resources []string
+		outputs   []string
+	}{
+		{
+			name:      "affected by input through locals",
+			input:     "tags",
+			resources: []string{"aws_instance.this", "module.dns"},
+			outputs:   []string{"fqdn", "id"},
+		},
+		{
+			name:      "affected by input directly and through locals",
+			input:     "name",
+			resources: []string{"aws_instance.this", "module.dns"},
+			outputs:   []string{"fqdn", "id"},
+		},
+		{
+			name:      "affected providers by input",
+			input:     "region",
+			resources: []string{"provider.aws", "provider.aws.dns"},
+			outputs:   []string{},
+		},
+		{
+			name:      "affected by unused input",
+			input:     "unused",
+			resources: []string{},
+			outputs:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(tt.resources, module.AffectedResources(tt.input))
+			assert.Equal(tt.outputs, module.AffectedOutputs(tt.input))
+		})
+	}
+}
+
+func TestReferenceAddress(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		expected   string
+	}{
+		{
+			name:       "reference address of input",
+			expression: "var.name",
+			expected:   "var.name",
+		},
+		{
+			name:       "reference address of attribute of resource",
+			expression: "aws_iam_role.this.arn",
+			expected:   "aws_iam_role.this",
+		},
+		{
+			name:       "reference address of attribute of data source",
+			expression: "data.aws_ami.this.id",
+			expected:   "data.aws_ami.this",
+		},
+		{
+			name:       "reference address of output of module",
+			expression: "module.vpc.id",
+			expected:   "module.vpc",
+		},
+		{
+			name:       "reference address of indexed resource",
+			expression: "aws_instance.this[0].id",
+			expected:   "aws_instance.this",
+		},
+		{
+			name:       "reference address of incomplete reference",
+			expression: "data.aws_ami",
+			expected:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			expr, diags := hclsyntax.ParseExpression([]byte(tt.expression), "", hcl.Pos{Line: 1, Column: 1})
+			assert.False(diags.HasErrors())
+
+			traversals := expr.Variables()
+			assert.Equal(1, len(traversals))
+			assert.Equal(tt.expected, referenceAddress(traversals[0]))
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	terraformsdk "github.com/terraform-docs/plugin-sdk/terraform"
@@ -27,6 +28,8 @@ type Input struct {
 	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
 	Default     types.Value  `json:"default" toml:"default" xml:"default" yaml:"default"`
 	Required    bool         `json:"required" toml:"required" xml:"required" yaml:"required"`
+	References  []*Reference `json:"references,omitempty" toml:"references,omitempty" xml:"references,omitempty" yaml:"references,omitempty"`
+	Unused      bool         `json:"unused,omitempty" toml:"unused,omitempty" xml:"unused,omitempty" yaml:"unused,omitempty"`
 	Position    Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
 }
 
@@ -52,11 +55,47 @@ func (i *Input) GetValue() string {
 	return value // everything else
 }
 
+// GetHCLValue returns HCL representation of the 'Default' value, which is an
+// 'interface'. Similar to GetValue, empty string is returned for a required
+// input without default value.
+func (i *Input) GetHCLValue() string {
+	value := types.HCL(i.Default)
+	if value == `null` && i.Required {
+		return ""
+	}
+	return value
+}
+
 // HasDefault indicates if a Terraform variable has a default value set.
 func (i *Input) HasDefault() bool {
 	return i.Default.HasDefault() || !i.Required
 }
 
+// UsedBy returns sorted addresses of the items of the module referencing the
+// input, without duplicates.
+func (i *Input) UsedBy() []string {
+	seen := make(map[string]bool)
+	addresses := []string{}
+	for _, r := range i.References {
+		if !seen[r.Address] {
+			seen[r.Address] = true
+			addresses = append(addresses, r.Address)
+		}
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
+// TypeConstraint returns the parsed type constraint of the input. If the type
+// cannot be parsed 'any' is returned.
+func (i *Input) TypeConstraint() *types.Constraint {
+	constraint, err := types.ParseConstraint(string(i.Type))
+	if err != nil {
+		return &types.Constraint{Kind: types.KindAny}
+	}
+	return constraint
+}
+
 type inputs []*Input
 
 func (ii inputs) convert() []*terraformsdk.Input {
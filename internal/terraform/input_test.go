@@ -211,6 +211,77 @@ func TestInputValue(t *testing.T) {
 	}
 }
 
+func TestInputHCLValue(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       Input
+		expectValue string
+	}{
+		{
+			name:        "required input without default",
+			input:       Input{Name: "input", Default: types.ValueOf(nil), Required: true},
+			expectValue: "",
+		},
+		{
+			name:        "optional input with null default",
+			input:       Input{Name: "input", Default: types.ValueOf(nil), Required: false},
+			expectValue: "null",
+		},
+		{
+			name:        "number default",
+			input:       Input{Name: "input", Default: types.ValueOf(13.75), Required: false},
+			expectValue: "13.75",
+		},
+		{
+			name:        "list default",
+			input:       Input{Name: "input", Default: types.ValueOf([]interface{}{"a", "b"}), Required: false},
+			expectValue: "[\n  \"a\",\n  \"b\",\n]",
+		},
+		{
+			name:        "map default",
+			input:       Input{Name: "input", Default: types.ValueOf(map[string]interface{}{"a": 1, "bb": true}), Required: false},
+			expectValue: "{\n  a  = 1\n  bb = true\n}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expectValue, tt.input.GetHCLValue())
+		})
+	}
+}
+
+func TestInputUsedBy(t *testing.T) {
+	tests := []struct {
+		name       string
+		references []*Reference
+		expected   []string
+	}{
+		{
+			name:       "input used by nothing",
+			references: []*Reference{},
+			expected:   []string{},
+		},
+		{
+			name: "input used by items",
+			references: []*Reference{
+				{Address: "module.dns", Filename: "main.tf", Line: 35},
+				{Address: "aws_instance.this", Filename: "main.tf", Line: 12},
+				{Address: "module.dns", Filename: "main.tf", Line: 36},
+			},
+			expected: []string{"aws_instance.this", "module.dns"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			input := Input{Name: "input", References: tt.references}
+
+			assert.Equal(tt.expected, input.UsedBy())
+		})
+	}
+}
+
 func TestInputsSortedByName(t *testing.T) {
 	assert := assert.New(t)
 	inputs := sampleInputs()
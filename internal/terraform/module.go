@@ -30,16 +30,22 @@ import (
 
 // Module represents a Terraform module. It consists of
 //
-// - Header       ('header' json key):        Module header found in shape of multi line comments at the beginning of 'main.tf'
-// - Inputs       ('inputs' json key):        List of input 'variables' extracted from the Terraform module .tf files
-// - ModuleCalls  ('modules' json key):       List of 'modules' extracted from the Terraform module .tf files
-// - Outputs      ('outputs' json key):       List of 'outputs' extracted from Terraform module .tf files
-// - Providers    ('providers' json key):     List of 'providers' extracted from resources used in Terraform module
-// - Requirements ('requirements' json key):  List of 'requirements' extracted from the Terraform module .tf files
-// - Resources    ('resources' json key):     List of 'resources' extracted from the Terraform module .tf files
+// - SchemaVersion ('schemaVersion' json key): Version of the output schema the module is rendered with
+// - Header        ('header' json key):        Module header found in shape of multi line comments at the beginning of 'main.tf'
+// - Inputs        ('inputs' json key):        List of input 'variables' extracted from the Terraform module .tf files
+// - ModuleCalls   ('modules' json key):       List of 'modules' extracted from the Terraform module .tf files
+// - Outputs       ('outputs' json key):       List of 'outputs' extracted from Terraform module .tf files
+// - Providers     ('providers' json key):     List of 'providers' extracted from resources used in Terraform module
+// - Requirements  ('requirements' json key):  List of 'requirements' extracted from the Terraform module .tf files
+// - Resources     ('resources' json key):     List of 'resources' extracted from the Terraform module .tf files
+// - DataFlow      ('dataFlow' json key):      List of references between items of the module, only if it's loaded
+//
+// Usage of the module, i.e. 'module' block calling it, is only available to templates.
 type Module struct {
 	XMLName xml.Name `json:"-" toml:"-" xml:"module" yaml:"-"`
 
+	SchemaVersion int `json:"schemaVersion" toml:"schemaVersion" xml:"schemaVersion" yaml:"schemaVersion"`
+
 	Header       string         `json:"header" toml:"header" xml:"header" yaml:"header"`
 	Inputs       []*Input       `json:"inputs" toml:"inputs" xml:"inputs>input" yaml:"inputs"`
 	ModuleCalls  []*ModuleCall  `json:"modules" toml:"modules" xml:"modules>module" yaml:"modules"`
@@ -47,9 +53,21 @@ type Module struct {
 	Providers    []*Provider    `json:"providers" toml:"providers" xml:"providers>provider" yaml:"providers"`
 	Requirements []*Requirement `json:"requirements" toml:"requirements" xml:"requirements>requirement" yaml:"requirements"`
 	Resources    []*Resource    `json:"resources" toml:"resources" xml:"resources>resource" yaml:"resources"`
+	DataFlow     []*DataFlow    `json:"dataFlow,omitempty" toml:"dataFlow,omitempty" xml:"dataFlow,omitempty" yaml:"dataFlow,omitempty"`
 
 	RequiredInputs []*Input `json:"-" toml:"-" xml:"-" yaml:"-"`
 	OptionalInputs []*Input `json:"-" toml:"-" xml:"-" yaml:"-"`
+
+	Usage string `json:"-" toml:"-" xml:"-" yaml:"-"`
+
+	path   string        // path of the module, to resolve the files and the 'module' block calling it
+	usage  *Usage        // usage options of the 'module' block calling it
+	source *callerSource // source of the 'module' block calling it, if it's inferred
+}
+
+// Path returns the path the module is loaded from.
+func (m *Module) Path() string {
+	return m.path
 }
 
 // HasHeader indicates if the module has header.
@@ -57,6 +75,22 @@ func (m *Module) HasHeader() bool {
 	return len(m.Header) > 0
 }
 
+// HasUsage indicates if the module has usage.
+func (m *Module) HasUsage() bool {
+	return len(m.Usage) > 0
+}
+
+// HasDataFlow indicates if the module has data flow.
+func (m *Module) HasDataFlow() bool {
+	return len(m.DataFlow) > 0
+}
+
+// DataFlowKnown indicates if the data flow of the module is known, which isn't
+// the case if it's not loaded or the module has any '.tf.json' file.
+func (m *Module) DataFlowKnown() bool {
+	return m.DataFlow != nil
+}
+
 // HasInputs indicates if the module has inputs.
 func (m *Module) HasInputs() bool {
 	return len(m.Inputs) > 0
@@ -72,6 +106,18 @@ func (m *Module) HasOutputs() bool {
 	return len(m.Outputs) > 0
 }
 
+// OutputSourcesKnown indicates if the sources of outputs of the module are
+// known, which isn't the case if they're not loaded or the module has any
+// '.tf.json' file.
+func (m *Module) OutputSourcesKnown() bool {
+	for _, o := range m.Outputs {
+		if o.Sources != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // HasProviders indicates if the module has providers.
 func (m *Module) HasProviders() bool {
 	return len(m.Providers) > 0
@@ -114,6 +160,10 @@ func LoadWithOptions(options *Options) (*Module, error) {
 		return nil, err
 	}
 	sortItems(module, options.SortBy)
+	module.path = options.Path
+	module.usage = options.Usage
+	module.source = &callerSource{}
+	module.Usage = loadUsage(module, options) // after sort to list inputs in the same order
 	return module, nil
 }
 
@@ -141,6 +191,25 @@ func loadModuleItems(tfmodule *tfconfig.Module, options *Options) (*Module, erro
 	requirements := loadRequirements(tfmodule)
 	resources := loadResources(tfmodule)
 
+	var dataflow []*DataFlow
+	if options.DataFlow {
+		if dataflow, err = loadDataFlow(options.Path); err != nil {
+			return nil, err
+		}
+	}
+	if options.InputReferences {
+		references, err := loadInputReferences(options.Path)
+		if err != nil {
+			return nil, err
+		}
+		// inputs which are not parsed (e.g. declared in '.tf.json' files) are never unused
+		for _, i := range inputs {
+			list, ok := references[i.Name]
+			i.References = list
+			i.Unused = ok && len(list) == 0
+		}
+	}
+
 	return &Module{
 		Header:       header,
 		Inputs:       inputs,
@@ -149,6 +218,7 @@ func loadModuleItems(tfmodule *tfconfig.Module, options *Options) (*Module, erro
 		Providers:    providers,
 		Requirements: requirements,
 		Resources:    resources,
+		DataFlow:     dataflow,
 
 		RequiredInputs: required,
 		OptionalInputs: optional,
@@ -170,10 +240,10 @@ func isFileFormatSupported(filename string) (bool, error) {
 		return false, fmt.Errorf("--header-from value is missing")
 	}
 	switch getFileFormat(filename) {
-	case ".adoc", ".md", ".tf", ".txt":
+	case ".adoc", ".md", ".rst", ".tf", ".txt":
 		return true, nil
 	}
-	return false, fmt.Errorf("only .adoc, .md, .tf and .txt formats are supported to read header from")
+	return false, fmt.Errorf("only .adoc, .md, .rst, .tf and .txt formats are supported to read header from")
 }
 
 func loadHeader(options *Options) (string, error) {
@@ -279,6 +349,14 @@ func loadOutputs(tfmodule *tfconfig.Module, options *Options) ([]*Output, error)
 			return nil, err
 		}
 	}
+	sources := make(map[string][]string)
+	if options.OutputSources {
+		var err error
+		sources, err = loadOutputSources(options.Path)
+		if err != nil {
+			return nil, err
+		}
+	}
 	for _, o := range tfmodule.Outputs {
 		description := o.Description
 		if description == "" {
@@ -292,6 +370,7 @@ func loadOutputs(tfmodule *tfconfig.Module, options *Options) ([]*Output, error)
 				Line:     o.Pos.Line,
 			},
 			ShowValue: options.OutputValues,
+			Sources:   sources[o.Name],
 		}
 		if options.OutputValues {
 			output.Sensitive = values[output.Name].Sensitive
@@ -338,6 +417,10 @@ func loadProviders(tfmodule *tfconfig.Module) []*Provider {
 				version = strings.Join(rv.VersionConstraints, " ")
 			}
 			key := fmt.Sprintf("%s.%s", r.Provider.Name, r.Provider.Alias)
+			// keep position of the first resource of the provider, regardless of order of the maps
+			if p, ok := discovered[key]; ok && !(Position{Filename: r.Pos.Filename, Line: r.Pos.Line}).before(p.Position) {
+				continue
+			}
 			discovered[key] = &Provider{
 				Name:    r.Provider.Name,
 				Alias:   types.String(r.Provider.Alias),
@@ -398,12 +481,18 @@ func loadResources(tfmodule *tfconfig.Module) []*Resource {
 			}
 			rType := strings.TrimPrefix(r.Type, r.Provider.Name+"_")
 			key := fmt.Sprintf("%s.%s.%s", r.Provider.Name, r.Mode, rType)
+			if d, ok := discovered[key]; ok {
+				d.Names = append(d.Names, r.Name)
+				sort.Strings(d.Names)
+				continue
+			}
 			discovered[key] = &Resource{
 				Type:           rType,
 				Mode:           r.Mode.String(),
 				ProviderName:   r.Provider.Name,
 				ProviderSource: source,
 				Version:        types.String(version),
+				Names:          []string{r.Name},
 			}
 		}
 	}
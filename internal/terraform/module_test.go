@@ -139,6 +139,13 @@ func TestIsFileFormatSupported(t *testing.T) {
 			wantErr:  false,
 			errText:  "",
 		},
+		{
+			name:     "is file format supported",
+			filename: "main.rst",
+			expected: true,
+			wantErr:  false,
+			errText:  "",
+		},
 		{
 			name:     "is file format supported",
 			filename: "main.txt",
@@ -151,7 +158,7 @@ func TestIsFileFormatSupported(t *testing.T) {
 			filename: "main.doc",
 			expected: false,
 			wantErr:  true,
-			errText:  "only .adoc, .md, .tf and .txt formats are supported to read header from",
+			errText:  "only .adoc, .md, .rst, .tf and .txt formats are supported to read header from",
 		},
 		{
 			name:     "is file format supported",
@@ -217,6 +224,14 @@ func TestLoadHeader(t *testing.T) {
 			wantErr:  false,
 			errText:  "",
 		},
+		{
+			name:     "load module header from path",
+			path:     "full-example",
+			header:   "doc.rst",
+			expected: "Custom Header\n=============\n\nExample of 'foo_bar' module in ``foo_bar.tf``.\n\n- list item 1\n- list item 2\n",
+			wantErr:  false,
+			errText:  "",
+		},
 		{
 			name:     "load module header from path",
 			path:     "full-example",
@@ -247,7 +262,7 @@ func TestLoadHeader(t *testing.T) {
 			header:   "wrong-formate.docx",
 			expected: "",
 			wantErr:  true,
-			errText:  "only .adoc, .md, .tf and .txt formats are supported to read header from",
+			errText:  "only .adoc, .md, .rst, .tf and .txt formats are supported to read header from",
 		},
 		{
 			name:     "load module header from path",
@@ -408,6 +423,48 @@ func TestLoadInputsLineEnding(t *testing.T) {
 	}
 }
 
+func TestLoadModuleWithInputReferences(t *testing.T) {
+	tests := []struct {
+		name       string
+		references bool
+		used       map[string]bool
+		unused     map[string]bool
+	}{
+		{
+			name:       "load module with input references",
+			references: true,
+			used:       map[string]bool{"dead": false, "name": true, "region": true, "tags": true, "unused": true},
+			unused:     map[string]bool{"dead": true, "name": false, "region": false, "tags": false, "unused": false},
+		},
+		{
+			name:       "load module without input references",
+			references: false,
+			used:       map[string]bool{"dead": false, "name": false, "region": false, "tags": false, "unused": false},
+			unused:     map[string]bool{"dead": false, "name": false, "region": false, "tags": false, "unused": false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options, _ := NewOptions().With(&Options{
+				Path:            filepath.Join("testdata", "dataflow"),
+				InputReferences: tt.references,
+			})
+			module, err := LoadWithOptions(options)
+			assert.Nil(err)
+
+			used := make(map[string]bool)
+			unused := make(map[string]bool)
+			for _, i := range module.Inputs {
+				used[i.Name] = len(i.References) > 0
+				unused[i.Name] = i.Unused
+			}
+			assert.Equal(tt.used, used)
+			assert.Equal(tt.unused, unused)
+		})
+	}
+}
+
 func TestLoadOutputs(t *testing.T) {
 	type expected struct {
 		outputs int
@@ -508,6 +565,51 @@ func TestLoadOutputsValues(t *testing.T) {
 	}
 }
 
+func TestLoadOutputsSources(t *testing.T) {
+	tests := []struct {
+		name     string
+		sources  bool
+		expected map[string][]string
+	}{
+		{
+			name:    "load module outputs with sources",
+			sources: true,
+			expected: map[string][]string{
+				"fqdn": {"module.dns.fqdn"},
+				"id":   {"aws_instance.this"},
+				"tags": {"var.name", "var.tags", "var.unused"},
+			},
+		},
+		{
+			name:    "load module outputs without sources",
+			sources: false,
+			expected: map[string][]string{
+				"fqdn": nil,
+				"id":   nil,
+				"tags": nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options, _ := NewOptions().With(&Options{
+				Path:          filepath.Join("testdata", "dataflow"),
+				OutputSources: tt.sources,
+			})
+			module, _ := loadModule(filepath.Join("testdata", "dataflow"))
+			outputs, err := loadOutputs(module, options)
+
+			assert.Nil(err)
+			actual := make(map[string][]string)
+			for _, o := range outputs {
+				actual[o.Name] = o.Sources
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
 func TestLoadProviders(t *testing.T) {
 	type expected struct {
 		providers int
@@ -543,6 +645,22 @@ func TestLoadProviders(t *testing.T) {
 	}
 }
 
+func TestLoadResources(t *testing.T) {
+	assert := assert.New(t)
+	module, _ := loadModule(filepath.Join("testdata", "dataflow-providers"))
+	resources := loadResources(module)
+
+	names := map[string][]string{}
+	for _, r := range resources {
+		names[r.Mode+"."+r.FullType()] = r.Names
+	}
+	assert.Equal(map[string][]string{
+		"managed.aws_s3_bucket": {"default", "east"},
+		"data.aws_region":       {"east"},
+		"managed.random_id":     {"this"},
+	}, names)
+}
+
 func TestLoadComments(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -32,6 +32,11 @@ type Options struct {
 	SortBy           *SortBy
 	OutputValues     bool
 	OutputValuesPath string
+	ShowUsage        bool
+	Usage            *Usage
+	DataFlow         bool
+	OutputSources    bool
+	InputReferences  bool
 }
 
 // NewOptions returns new instance of Options
@@ -43,6 +48,11 @@ func NewOptions() *Options {
 		SortBy:           &SortBy{Name: false, Required: false, Type: false},
 		OutputValues:     false,
 		OutputValuesPath: "",
+		ShowUsage:        false,
+		Usage:            &Usage{Name: "", Source: "", Version: "", Optional: false},
+		DataFlow:         false,
+		OutputSources:    false,
+		InputReferences:  false,
 	}
 }
 
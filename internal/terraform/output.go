@@ -26,6 +26,7 @@ type Output struct {
 	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
 	Value       types.Value  `json:"value,omitempty" toml:"value,omitempty" xml:"value,omitempty" yaml:"value,omitempty"`
 	Sensitive   bool         `json:"sensitive,omitempty" toml:"sensitive,omitempty" xml:"sensitive,omitempty" yaml:"sensitive,omitempty"`
+	Sources     []string     `json:"sources,omitempty" toml:"sources,omitempty" xml:"sources,omitempty" yaml:"sources,omitempty"`
 	Position    Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
 	ShowValue   bool         `json:"-" toml:"-" xml:"-" yaml:"-"`
 }
@@ -35,6 +36,7 @@ type withvalue struct {
 	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
 	Value       types.Value  `json:"value" toml:"value" xml:"value" yaml:"value"`
 	Sensitive   bool         `json:"sensitive" toml:"sensitive" xml:"sensitive" yaml:"sensitive"`
+	Sources     []string     `json:"sources,omitempty" toml:"sources,omitempty" xml:"sources,omitempty" yaml:"sources,omitempty"`
 	Position    Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
 	ShowValue   bool         `json:"-" toml:"-" xml:"-" yaml:"-"`
 }
@@ -57,6 +59,18 @@ func (o *Output) GetValue() string {
 	return value // everything else
 }
 
+// GetHCLValue returns HCL representation of the 'Value', which is an 'interface'.
+func (o *Output) GetHCLValue() string {
+	if !o.ShowValue || o.Value == nil {
+		return ""
+	}
+	value := types.HCL(o.Value)
+	if value == `null` {
+		return "" // types.Nil
+	}
+	return value // everything else
+}
+
 // HasDefault indicates if a Terraform output has a default value set.
 func (o *Output) HasDefault() bool {
 	if !o.ShowValue || o.Value == nil {
@@ -105,6 +119,9 @@ func (o *Output) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 		fn(o.Value, "value")         //nolint: errcheck
 		fn(o.Sensitive, "sensitive") //nolint: errcheck
 	}
+	if len(o.Sources) > 0 {
+		fn(o.Sources, "sources") //nolint: errcheck
+	}
 	return e.EncodeToken(start.End())
 }
 
@@ -113,6 +113,82 @@ func TestOutputValue(t *testing.T) {
 	}
 }
 
+func TestOutputHCLValue(t *testing.T) {
+	outputs := sampleOutputs()
+	tests := []struct {
+		name        string
+		output      Output
+		expectValue string
+	}{
+		{
+			name:        "output HCL Value",
+			output:      outputs[0],
+			expectValue: "",
+		},
+		{
+			name:        "output HCL Value",
+			output:      outputs[1],
+			expectValue: "",
+		},
+		{
+			name:        "output HCL Value",
+			output:      outputs[2],
+			expectValue: "false",
+		},
+		{
+			name:        "output HCL Value",
+			output:      outputs[3],
+			expectValue: "\"\"",
+		},
+		{
+			name:        "output HCL Value",
+			output:      outputs[4],
+			expectValue: "\"foo\"",
+		},
+		{
+			name:        "output HCL Value",
+			output:      outputs[5],
+			expectValue: "",
+		},
+		{
+			name:        "output HCL Value",
+			output:      outputs[6],
+			expectValue: "\"<sensitive>\"",
+		},
+		{
+			name:        "output HCL Value",
+			output:      outputs[7],
+			expectValue: "[\n  \"a\",\n  \"b\",\n  \"c\",\n]",
+		},
+		{
+			name:        "output HCL Value",
+			output:      outputs[8],
+			expectValue: "[]",
+		},
+		{
+			name:        "output HCL Value",
+			output:      outputs[9],
+			expectValue: "{\n  a = 1\n  b = 2\n  c = 3\n}",
+		},
+		{
+			name:        "output HCL Value",
+			output:      outputs[10],
+			expectValue: "{}",
+		},
+		{
+			name:        "output HCL Value",
+			output:      outputs[11],
+			expectValue: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expectValue, tt.output.GetHCLValue())
+		})
+	}
+}
+
 func TestOutputMarshalJSON(t *testing.T) {
 	outputs := sampleOutputs()
 	tests := []struct {
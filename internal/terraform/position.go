@@ -15,3 +15,9 @@ type Position struct {
 	Filename string `json:"-" toml:"-" xml:"-" yaml:"-"`
 	Line     int    `json:"-" toml:"-" xml:"-" yaml:"-"`
 }
+
+// before returns true if 'p' is located before 'other', i.e. in a file with
+// lower name or earlier in the same file.
+func (p Position) before(other Position) bool {
+	return p.Filename < other.Filename || (p.Filename == other.Filename && p.Line < other.Line)
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPositionBefore(t *testing.T) {
+	tests := []struct {
+		name     string
+		position Position
+		other    Position
+		expected bool
+	}{
+		{
+			name:     "earlier line in the same file",
+			position: Position{Filename: "main.tf", Line: 5},
+			other:    Position{Filename: "main.tf", Line: 10},
+			expected: true,
+		},
+		{
+			name:     "later line in the same file",
+			position: Position{Filename: "main.tf", Line: 10},
+			other:    Position{Filename: "main.tf", Line: 5},
+			expected: false,
+		},
+		{
+			name:     "same position",
+			position: Position{Filename: "main.tf", Line: 5},
+			other:    Position{Filename: "main.tf", Line: 5},
+			expected: false,
+		},
+		{
+			name:     "file with lower name",
+			position: Position{Filename: "data.tf", Line: 10},
+			other:    Position{Filename: "main.tf", Line: 5},
+			expected: true,
+		},
+		{
+			name:     "file with higher name",
+			position: Position{Filename: "variables.tf", Line: 1},
+			other:    Position{Filename: "main.tf", Line: 5},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, tt.position.before(tt.other))
+		})
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// Reference represents a reference to an input, i.e. 'var.<name>', in 'Filename'
+// (relative to the module) at 'Line', from the item of the module at 'Address'
+// (e.g. 'aws_instance.this', 'local.tags' or 'output.id'), or the block type and
+// labels of the block which doesn't declare any item (e.g. 'provider.aws' or
+// 'check.health').
+type Reference struct {
+	Address  string `json:"address" toml:"address" xml:"address" yaml:"address"`
+	Filename string `json:"filename" toml:"filename" xml:"filename" yaml:"filename"`
+	Line     int    `json:"line" toml:"line" xml:"line" yaml:"line"`
+}
+
+type referencesSortedByPosition []*Reference
+
+func (a referencesSortedByPosition) Len() int      { return len(a) }
+func (a referencesSortedByPosition) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a referencesSortedByPosition) Less(i, j int) bool {
+	if a[i].Filename != a[j].Filename {
+		return a[i].Filename < a[j].Filename
+	}
+	if a[i].Line != a[j].Line {
+		return a[i].Line < a[j].Line
+	}
+	return a[i].Address < a[j].Address
+}
+
+// loadInputReferences returns the references to inputs of the module at 'path',
+// keyed by name of the inputs. References are collected from all the top-level
+// blocks, e.g. 'check' and 'import' too, and inputs which are declared but not
+// referenced have an empty list of references. If the module has any '.tf.json'
+// file, which isn't parsed, its references are unknown and nil is returned.
+func loadInputReferences(path string) (map[string][]*Reference, error) {
+	bodies, err := parseBodies(path)
+	if err != nil || bodies == nil {
+		return nil, err
+	}
+
+	references := make(map[string][]*Reference)
+	referrers := make(map[string][]*hclsyntax.Body)
+	for _, body := range bodies {
+		for _, block := range body.Blocks {
+			if block.Type == "locals" {
+				for name, attr := range block.Body.Attributes {
+					referrers["local."+name] = append(referrers["local."+name], &hclsyntax.Body{Attributes: hclsyntax.Attributes{name: attr}})
+				}
+				continue
+			}
+			address := blockAddress(block)
+			if address == "" {
+				address = strings.Join(append([]string{block.Type}, block.Labels...), ".")
+			}
+			if block.Type == "variable" && len(block.Labels) == 1 {
+				references[block.Labels[0]] = []*Reference{}
+			}
+			referrers[address] = append(referrers[address], block.Body)
+		}
+	}
+
+	type key struct {
+		name string
+		Reference
+	}
+	seen := make(map[key]bool)
+	for address, bodies := range referrers {
+		for _, body := range bodies {
+			for _, traversal := range bodyReferences(body) {
+				from := referenceAddress(traversal)
+				name := strings.TrimPrefix(from, "var.")
+				if _, ok := references[name]; !ok || !strings.HasPrefix(from, "var.") || from == address {
+					continue
+				}
+				r := Reference{
+					Address:  address,
+					Filename: traversal.SourceRange().Filename,
+					Line:     traversal.SourceRange().Start.Line,
+				}
+				if rel, err := filepath.Rel(path, r.Filename); err == nil {
+					r.Filename = filepath.ToSlash(rel)
+				}
+				if seen[key{name, r}] {
+					continue
+				}
+				seen[key{name, r}] = true
+				references[name] = append(references[name], &r)
+			}
+		}
+	}
+	for _, list := range references {
+		sort.Sort(referencesSortedByPosition(list))
+	}
+	return references, nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadInputReferences(t *testing.T) {
+	assert := assert.New(t)
+
+	references, err := loadInputReferences(filepath.Join("testdata", "dataflow"))
+	assert.Nil(err)
+	assert.Equal(map[string][]*Reference{
+		"dead": {},
+		"name": {
+			{Address: "local.tags", Filename: "main.tf", Line: 16},
+			{Address: "module.dns", Filename: "main.tf", Line: 35},
+		},
+		"region": {
+			{Address: "provider.aws", Filename: "providers.tf", Line: 6},
+			{Address: "provider.aws.dns", Filename: "providers.tf", Line: 11},
+		},
+		"tags": {
+			{Address: "local.tags", Filename: "main.tf", Line: 16},
+		},
+		"unused": {
+			{Address: "output.tags", Filename: "main.tf", Line: 48},
+		},
+	}, references)
+}
+
+func TestLoadInputReferencesOtherBlocks(t *testing.T) {
+	assert := assert.New(t)
+
+	references, err := loadInputReferences(filepath.Join("testdata", "references"))
+	assert.Nil(err)
+	assert.Equal(map[string][]*Reference{
+		"endpoint": {
+			{Address: "check.health", Filename: "main.tf", Line: 21},
+		},
+		"instance_id": {
+			{Address: "import", Filename: "main.tf", Line: 27},
+		},
+		"threshold": {},
+	}, references)
+}
+
+func TestLoadInputReferencesJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	references, err := loadInputReferences(filepath.Join("testdata", "references-json"))
+	assert.Nil(err)
+	assert.Nil(references)
+}
+
+func TestLoadInputReferencesUnused(t *testing.T) {
+	assert := assert.New(t)
+
+	references, err := loadInputReferences(filepath.Join("testdata", "full-example"))
+	assert.Nil(err)
+	assert.NotEmpty(references)
+	for name, list := range references {
+		assert.Equal([]*Reference{}, list, name)
+	}
+}
@@ -22,9 +22,13 @@ import (
 type Resource struct {
 	Type           string       `json:"type" toml:"type" xml:"type" yaml:"type"`
 	ProviderName   string       `json:"providerName" toml:"providerName" xml:"providerName" yaml:"providerName"`
-	ProviderSource string       `json:"provicerSource" toml:"providerSource" xml:"providerSource" yaml:"providerSource"`
+	ProviderSource string       `json:"providerSource" toml:"providerSource" xml:"providerSource" yaml:"providerSource"`
 	Mode           string       `json:"mode" toml:"mode" xml:"mode" yaml:"mode"`
 	Version        types.String `json:"version" toml:"version" xml:"version" yaml:"version"`
+
+	// Names are the sorted names of the blocks declaring resources of this type,
+	// e.g. 'this' of 'resource "tls_private_key" "this"'.
+	Names []string `json:"-" toml:"-" xml:"-" yaml:"-"`
 }
 
 // FullType returns full name of the type of the resource, including the provider name
@@ -0,0 +1,49 @@
+variable "region" {
+  type = string
+}
+
+variable "east_region" {
+  type = string
+}
+
+provider "aws" {
+  region = var.region
+}
+
+provider "aws" {
+  alias  = "east"
+  region = var.east_region
+}
+
+resource "aws_s3_bucket" "default" {
+  bucket = "default"
+}
+
+resource "aws_s3_bucket" "east" {
+  provider = aws.east
+  bucket   = "east"
+}
+
+data "aws_region" "east" {
+  provider = aws.east
+}
+
+resource "random_id" "this" {
+  byte_length = 8
+}
+
+module "logs" {
+  source = "./logs"
+}
+
+module "replica" {
+  source = "./replica"
+
+  providers = {
+    aws = aws.east
+  }
+}
+
+output "bucket" {
+  value = aws_s3_bucket.east.id
+}
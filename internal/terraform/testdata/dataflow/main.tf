@@ -0,0 +1,49 @@
+variable "name" {
+  type = string
+}
+
+variable "tags" {
+  type    = map(string)
+  default = {}
+}
+
+variable "unused" {
+  type    = string
+  default = ""
+}
+
+locals {
+  tags = merge(var.tags, { Name = var.name })
+}
+
+data "aws_ami" "this" {
+  most_recent = true
+}
+
+resource "aws_instance" "this" {
+  ami  = data.aws_ami.this.id
+  tags = local.tags
+
+  root_block_device {
+    tags = local.tags
+  }
+}
+
+module "dns" {
+  source = "./dns"
+
+  name      = var.name
+  target_ip = aws_instance.this.private_ip
+}
+
+output "id" {
+  value = aws_instance.this.id
+}
+
+output "fqdn" {
+  value = module.dns.fqdn
+}
+
+output "tags" {
+  value = { for k, v in local.tags : k => v if k != var.unused }
+}
@@ -0,0 +1,17 @@
+variable "region" {
+  type = string
+}
+
+provider "aws" {
+  region = var.region
+}
+
+provider "aws" {
+  alias  = "dns"
+  region = var.region
+}
+
+variable "dead" {
+  type    = string
+  default = ""
+}
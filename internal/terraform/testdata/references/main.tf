@@ -0,0 +1,31 @@
+variable "endpoint" {
+  type = string
+}
+
+variable "instance_id" {
+  type = string
+}
+
+variable "threshold" {
+  type    = number
+  default = 1
+
+  validation {
+    condition     = var.threshold > 0
+    error_message = "The threshold must be positive."
+  }
+}
+
+check "health" {
+  assert {
+    condition     = var.endpoint != ""
+    error_message = "The endpoint must not be empty."
+  }
+}
+
+import {
+  id = var.instance_id
+  to = aws_instance.this
+}
+
+resource "aws_instance" "this" {}
@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraform
+
+import (
+	"net/url"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// Usage contains the options of the usage example of the module, i.e. the
+// 'module' block calling it.
+//
+// If Source is empty it's inferred from remote 'origin' of the git repository
+// of the module, pinned to Version or the latest tag of the repository. It
+// falls back to the path of the module if it's not in a git repository.
+// Otherwise Version, if any, is set as 'version' of the block which is only
+// supported by modules from a registry.
+type Usage struct {
+	Name     string
+	Source   string
+	Version  string
+	Optional bool
+}
+
+// callerSource is the source of the 'module' block calling the module inferred
+// from its git repository, resolved once as it runs git.
+type callerSource struct {
+	once  sync.Once
+	value string
+}
+
+// CallerName returns name of the module in the 'module' block calling it,
+// which is Name of the usage options or the name of its directory.
+func (m *Module) CallerName() string {
+	if m.usage != nil && m.usage.Name != "" {
+		return m.usage.Name
+	}
+	return usageName(m.path)
+}
+
+// CallerBlock returns the 'module' block calling the module, with its name,
+// source and version resolved from the usage options. Inputs are set, after a
+// blank line, to the value returned by 'value', and the ones it returns nil
+// for are skipped.
+func (m *Module) CallerBlock(value func(*Input) interface{}) string {
+	usage := m.usage
+	if usage == nil {
+		usage = &Usage{}
+	}
+
+	keys := []string{"source"}
+	attributes := map[string]interface{}{}
+	if usage.Source != "" {
+		attributes["source"] = usage.Source
+		if usage.Version != "" {
+			keys = append(keys, "version")
+			attributes["version"] = usage.Version
+		}
+	} else {
+		attributes["source"] = m.inferredSource(usage.Version)
+	}
+
+	for _, i := range m.Inputs {
+		v := value(i)
+		if v == nil {
+			continue
+		}
+		if len(attributes) == len(keys) {
+			keys = append(keys, "") // blank line after source and version
+		}
+		keys = append(keys, i.Name)
+		attributes[i.Name] = v
+	}
+	return types.HCLBlock("module", []string{m.CallerName()}, keys, attributes)
+}
+
+// inferredSource returns source of the 'module' block calling the module
+// inferred from its git repository, pinned to 'ref'. It's resolved on first use
+// and shared by the copies of the module.
+func (m *Module) inferredSource(ref string) string {
+	if m.source == nil {
+		return usageSource(m.path, ref)
+	}
+	m.source.once.Do(func() {
+		m.source.value = usageSource(m.path, ref)
+	})
+	return m.source.value
+}
+
+// RequiredPlaceholder returns placeholder of input 'i' based on its type if it's
+// required, or nil otherwise, to be used as value of CallerBlock.
+func RequiredPlaceholder(i *Input) interface{} {
+	if !i.Required {
+		return nil
+	}
+	return i.TypeConstraint().Placeholder()
+}
+
+// loadUsage returns the usage example of 'module', i.e. 'module' block calling
+// it with its required inputs, or an empty string if it's not shown. Optional
+// inputs are also included, commented out, if it's set in 'options'.
+func loadUsage(module *Module, options *Options) string {
+	if !options.ShowUsage {
+		return ""
+	}
+	block := module.CallerBlock(RequiredPlaceholder)
+	if options.Usage == nil || !options.Usage.Optional {
+		return block
+	}
+
+	// optional inputs are commented out, with their default as value
+	keys := []string{}
+	attributes := map[string]interface{}{}
+	for _, i := range module.Inputs {
+		if !i.Required {
+			keys = append(keys, i.Name)
+			attributes[i.Name] = i.Default
+		}
+	}
+	if len(keys) == 0 {
+		return block
+	}
+	lines := strings.Split(types.HCLBlock("module", nil, keys, attributes), "\n")
+	lines = lines[1 : len(lines)-1]
+	for n, line := range lines {
+		lines[n] = "  # " + strings.TrimPrefix(line, "  ")
+	}
+	return strings.TrimSuffix(block, "}") + "\n" + strings.Join(lines, "\n") + "\n}"
+}
+
+// usageName returns name of the module in the usage example, which is the name
+// of its directory made into a valid identifier.
+func usageName(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	name := regexp.MustCompile(`[^a-zA-Z0-9_-]+`).ReplaceAllString(filepath.Base(path), "_")
+	if name == "" || !regexp.MustCompile(`^[a-zA-Z_]`).MatchString(name) {
+		name = "_" + name
+	}
+	return name
+}
+
+// usageSource returns source of the module at 'path' based on remote 'origin'
+// of its git repository, pinned to 'ref' or the latest tag if it's empty. The
+// relative path of the module is returned if it's not in a git repository or
+// the repository has no remote, or git isn't available.
+func usageSource(path string, ref string) string {
+	if _, err := exec.LookPath("git"); err != nil {
+		return localSource(path)
+	}
+	remote := git(path, "config", "--get", "remote.origin.url")
+	if remote == "" {
+		return localSource(path)
+	}
+	source := gitSource(remote)
+	if prefix := strings.TrimSuffix(git(path, "rev-parse", "--show-prefix"), "/"); prefix != "" {
+		source += "//" + prefix
+	}
+	if ref == "" {
+		ref = git(path, "describe", "--tags", "--abbrev=0")
+	}
+	if ref != "" {
+		source += "?ref=" + url.QueryEscape(ref)
+	}
+	return source
+}
+
+// gitSource returns the module source address of git 'remote', without the
+// credentials it may contain.
+func gitSource(remote string) string {
+	if strings.HasPrefix(remote, "git::") {
+		return remote
+	}
+	if u, err := url.Parse(remote); err == nil && u.Scheme != "" && u.Host != "" {
+		switch {
+		case u.Scheme == "http" || u.Scheme == "https":
+			u.User = nil
+		case u.User != nil:
+			u.User = url.User(u.User.Username())
+		}
+		return "git::" + u.String()
+	}
+	// scp-like syntax, e.g. git@github.com:org/repo.git
+	if i := strings.Index(remote, ":"); i > 0 && !strings.Contains(remote[:i], "/") {
+		return "git::ssh://" + remote[:i] + "/" + strings.TrimPrefix(remote[i+1:], "/")
+	}
+	return "git::" + remote
+}
+
+// localSource returns 'path' as a local module source address.
+func localSource(path string) string {
+	path = filepath.ToSlash(filepath.Clean(path))
+	switch {
+	case path == ".":
+		return "./"
+	case filepath.IsAbs(path), strings.HasPrefix(path, "../"):
+		return path
+	}
+	return "./" + path
+}
+
+// git runs git command with 'args' in 'path' and returns its trimmed output,
+// or an empty string if it fails.
+func git(path string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = path
+	out, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
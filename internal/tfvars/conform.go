@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package tfvars
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// conform checks whether value 'v' can be converted to type constraint 'c'
+// the same way Terraform does it, and returns the description of the problem
+// if it can't. Empty string is returned if value conforms.
+func conform(c *types.Constraint, v cty.Value) string {
+	if v.IsNull() || c.Kind == types.KindAny {
+		return ""
+	}
+	t := v.Type()
+	switch c.Kind {
+	case types.KindString:
+		if t == cty.String || t == cty.Number || t == cty.Bool {
+			return ""
+		}
+	case types.KindNumber:
+		if t == cty.Number {
+			return ""
+		}
+		if t == cty.String {
+			if _, err := strconv.ParseFloat(v.AsString(), 64); err == nil {
+				return ""
+			}
+		}
+	case types.KindBool:
+		if t == cty.Bool {
+			return ""
+		}
+		if t == cty.String && (v.AsString() == "true" || v.AsString() == "false") {
+			return ""
+		}
+	case types.KindList, types.KindSet:
+		if t.IsTupleType() || t.IsListType() || t.IsSetType() {
+			return conformElements(c, v)
+		}
+	case types.KindMap:
+		if t.IsObjectType() || t.IsMapType() {
+			return conformElements(c, v)
+		}
+	case types.KindTuple:
+		if t.IsTupleType() || t.IsListType() {
+			if v.LengthInt() != len(c.Elements) {
+				return fmt.Sprintf("expected %d elements, got %d", len(c.Elements), v.LengthInt())
+			}
+			i := 0
+			for it := v.ElementIterator(); it.Next(); i++ {
+				_, e := it.Element()
+				if problem := conform(c.Elements[i], e); problem != "" {
+					return fmt.Sprintf("element %d: %s", i, problem)
+				}
+			}
+			return ""
+		}
+	case types.KindObject:
+		if t.IsObjectType() || t.IsMapType() {
+			values := v.AsValueMap()
+			for _, a := range c.Attributes {
+				value, ok := values[a.Name]
+				if !ok {
+					if a.Optional {
+						continue
+					}
+					return fmt.Sprintf("attribute '%s' is required", a.Name)
+				}
+				if problem := conform(a.Type, value); problem != "" {
+					return fmt.Sprintf("attribute '%s': %s", a.Name, problem)
+				}
+			}
+			return ""
+		}
+	}
+	return fmt.Sprintf("%s required, got %s", c.Kind, t.FriendlyName())
+}
+
+func conformElements(c *types.Constraint, v cty.Value) string {
+	for it := v.ElementIterator(); it.Next(); {
+		k, e := it.Element()
+		if problem := conform(c.Element, e); problem != "" {
+			return fmt.Sprintf("element %s: %s", keyOf(k), problem)
+		}
+	}
+	return ""
+}
+
+// keyOf returns the printable representation of element key 'k', which is
+// either a string (map and object) or a number (list, set and tuple).
+func keyOf(k cty.Value) string {
+	if k.Type() == cty.String {
+		return strconv.Quote(k.AsString())
+	}
+	if k.Type() == cty.Number {
+		return k.AsBigFloat().Text('f', -1)
+	}
+	return k.GoString()
+}
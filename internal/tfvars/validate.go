@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package tfvars
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+)
+
+// Diagnostic represents a problem found in a tfvars file. 'Line' and 'Column'
+// are zero if the problem doesn't belong to a specific place in the file (e.g.
+// a missing required input).
+type Diagnostic struct {
+	Filename string
+	Line     int
+	Column   int
+	Variable string
+	Message  string
+}
+
+// String returns diagnostic in 'file:line:column: message' format.
+func (d *Diagnostic) String() string {
+	if d.Line == 0 {
+		return fmt.Sprintf("%s: %s", d.Filename, d.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", d.Filename, d.Line, d.Column, d.Message)
+}
+
+// Validate parses tfvars file 'filename' (either in HCL or JSON format based
+// on its extension) and validates it against inputs of 'module'. It reports
+// unknown variables, missing required inputs and values which don't match
+// type of their corresponding input.
+func Validate(module *terraform.Module, filename string) ([]*Diagnostic, error) {
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tfvars file: %s", err)
+	}
+
+	parser := hclparse.NewParser()
+
+	var file *hcl.File
+	var diags hcl.Diagnostics
+	if strings.HasSuffix(filename, ".json") {
+		file, diags = parser.ParseJSON(src, filename)
+	} else {
+		file, diags = parser.ParseHCL(src, filename)
+	}
+	if diags.HasErrors() {
+		return nil, parseError(filename, diags)
+	}
+
+	attributes, diags := file.Body.JustAttributes()
+	if diags.HasErrors() {
+		return nil, parseError(filename, diags)
+	}
+
+	inputs := make(map[string]*terraform.Input)
+	for _, i := range module.Inputs {
+		inputs[i.Name] = i
+	}
+
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]*Diagnostic, 0)
+	for _, name := range names {
+		attribute := attributes[name]
+		diagnostic := &Diagnostic{
+			Filename: filename,
+			Line:     attribute.NameRange.Start.Line,
+			Column:   attribute.NameRange.Start.Column,
+			Variable: name,
+		}
+		input, ok := inputs[name]
+		if !ok {
+			diagnostic.Message = fmt.Sprintf("unknown variable '%s'", name)
+			result = append(result, diagnostic)
+			continue
+		}
+		value, diags := attribute.Expr.Value(nil)
+		if diags.HasErrors() {
+			diagnostic.Message = fmt.Sprintf("value of '%s' must be a literal value", name)
+			result = append(result, diagnostic)
+			continue
+		}
+		if problem := conform(input.TypeConstraint(), value); problem != "" {
+			diagnostic.Message = fmt.Sprintf("invalid value for '%s' of type '%s': %s", name, input.TypeConstraint(), problem)
+			result = append(result, diagnostic)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Line < result[j].Line
+	})
+
+	missing := make([]string, 0)
+	for _, i := range module.RequiredInputs {
+		if _, ok := attributes[i.Name]; !ok {
+			missing = append(missing, i.Name)
+		}
+	}
+	sort.Strings(missing)
+	for _, name := range missing {
+		result = append(result, &Diagnostic{
+			Filename: filename,
+			Variable: name,
+			Message:  fmt.Sprintf("missing required input '%s'", name),
+		})
+	}
+
+	return result, nil
+}
+
+// parseError returns the first error of 'diags' as an error in the same format
+// as Diagnostic, i.e. 'file:line:column: message'.
+func parseError(filename string, diags hcl.Diagnostics) error {
+	for _, diag := range diags {
+		if diag.Severity != hcl.DiagError {
+			continue
+		}
+		d := &Diagnostic{
+			Filename: filename,
+			Message:  diag.Summary,
+		}
+		if diag.Detail != "" {
+			d.Message += "; " + diag.Detail
+		}
+		if diag.Subject != nil {
+			d.Line = diag.Subject.Start.Line
+			d.Column = diag.Subject.Start.Column
+		}
+		return errors.New(d.String())
+	}
+	return fmt.Errorf("failed to parse tfvars file '%s'", filename)
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package tfvars
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		expected []string
+		wantErr  string
+	}{
+		{
+			name:     "valid hcl file",
+			filename: "valid.tfvars",
+			expected: []string{},
+		},
+		{
+			name:     "valid json file",
+			filename: "valid.tfvars.json",
+			expected: []string{},
+		},
+		{
+			name:     "invalid hcl file",
+			filename: "invalid.tfvars",
+			expected: []string{
+				"testdata/invalid.tfvars:3:1: invalid value for 'number-2' of type 'number': number required, got string",
+				"testdata/invalid.tfvars:4:1: invalid value for 'list-2' of type 'list(any)': list required, got string",
+				"testdata/invalid.tfvars:5:1: unknown variable 'unknown'",
+				"testdata/invalid.tfvars:7:1: invalid value for 'bool_default_false' of type 'bool': bool required, got number",
+				"testdata/invalid.tfvars:8:1: value of 'string_default_empty' must be a literal value",
+				"testdata/invalid.tfvars:10:1: invalid value for 'long_type' of type 'object({name = string, foo = object({foo = string, bar = string}), bar = object({foo = string, bar = string}), fizz = list(string), buzz = list(string)})': attribute 'foo': attribute 'bar' is required",
+				"testdata/invalid.tfvars: missing required input 'input_with_underscores'",
+				"testdata/invalid.tfvars: missing required input 'map-2'",
+				"testdata/invalid.tfvars: missing required input 'string_no_default'",
+			},
+		},
+		{
+			name:     "invalid json file",
+			filename: "invalid.tfvars.json",
+			expected: []string{
+				"testdata/invalid.tfvars.json:3:3: invalid value for 'number-2' of type 'number': number required, got tuple",
+				"testdata/invalid.tfvars.json:4:3: unknown variable 'unknown'",
+				"testdata/invalid.tfvars.json: missing required input 'input_with_underscores'",
+				"testdata/invalid.tfvars.json: missing required input 'list-2'",
+				"testdata/invalid.tfvars.json: missing required input 'map-2'",
+				"testdata/invalid.tfvars.json: missing required input 'string-2'",
+				"testdata/invalid.tfvars.json: missing required input 'string_no_default'",
+			},
+		},
+		{
+			name:     "malformed file",
+			filename: "malformed.tfvars",
+			expected: nil,
+			wantErr:  "testdata/malformed.tfvars:1:12: Invalid expression; Expected the start of an expression, but found an invalid expression token.",
+		},
+		{
+			name:     "missing file",
+			filename: "noop.tfvars",
+			expected: nil,
+			wantErr:  "failed to read tfvars file: open testdata/noop.tfvars: no such file or directory",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			options := terraform.NewOptions()
+			module, err := testutil.GetModule(options)
+			assert.Nil(err)
+
+			diagnostics, err := Validate(module, filepath.Join("testdata", tt.filename))
+
+			if tt.wantErr != "" {
+				assert.EqualError(err, tt.wantErr)
+				return
+			}
+
+			assert.Nil(err)
+
+			actual := make([]string, 0, len(diagnostics))
+			for _, d := range diagnostics {
+				actual = append(actual, d.String())
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Kind of a Terraform type constraint.
+type Kind string
+
+// Available kinds of Terraform type constraints.
+const (
+	KindAny    Kind = "any"
+	KindBool   Kind = "bool"
+	KindNumber Kind = "number"
+	KindString Kind = "string"
+	KindList   Kind = "list"
+	KindMap    Kind = "map"
+	KindSet    Kind = "set"
+	KindObject Kind = "object"
+	KindTuple  Kind = "tuple"
+)
+
+// Constraint represents a Terraform type constraint of a variable, for
+// example 'string', 'list(number)' or 'object({ name = string })'.
+//
+// - Element is set for collection kinds (list, map and set)
+// - Elements is set for tuple kind
+// - Attributes is set for object kind
+type Constraint struct {
+	Kind       Kind
+	Element    *Constraint
+	Elements   []*Constraint
+	Attributes []*Attribute
+}
+
+// Attribute represents an attribute of an 'object' type constraint.
+type Attribute struct {
+	Name     string
+	Type     *Constraint
+	Optional bool
+}
+
+// ParseConstraint parses type constraint expression 's' of a Terraform
+// variable. Empty expression and legacy quoted types (e.g. "string") are
+// supported, the former is treated as 'any'.
+func ParseConstraint(s string) (*Constraint, error) {
+	if strings.TrimSpace(s) == "" {
+		return &Constraint{Kind: KindAny}, nil
+	}
+	expr, diags := hclsyntax.ParseExpression([]byte(s), "", hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return constraintOf(expr)
+}
+
+func constraintOf(expr hcl.Expression) (*Constraint, error) {
+	switch e := expr.(type) {
+	case *hclsyntax.ScopeTraversalExpr, *hclsyntax.TemplateExpr:
+		keyword := hcl.ExprAsKeyword(expr)
+		if keyword == "" {
+			value, diags := expr.Value(nil)
+			if diags.HasErrors() || value.Type() != cty.String {
+				return nil, fmt.Errorf("invalid type constraint")
+			}
+			keyword = value.AsString() // legacy quoted type, e.g. "string"
+		}
+		switch Kind(keyword) {
+		case KindAny, KindBool, KindNumber, KindString:
+			return &Constraint{Kind: Kind(keyword)}, nil
+		case KindList, KindMap, KindSet:
+			return &Constraint{Kind: Kind(keyword), Element: &Constraint{Kind: KindAny}}, nil
+		}
+		return nil, fmt.Errorf("unknown type '%s'", keyword)
+	case *hclsyntax.FunctionCallExpr:
+		if len(e.Args) != 1 {
+			return nil, fmt.Errorf("type '%s' expects exactly one argument", e.Name)
+		}
+		switch Kind(e.Name) {
+		case KindList, KindMap, KindSet:
+			element, err := constraintOf(e.Args[0])
+			if err != nil {
+				return nil, err
+			}
+			return &Constraint{Kind: Kind(e.Name), Element: element}, nil
+		case KindTuple:
+			tuple, ok := e.Args[0].(*hclsyntax.TupleConsExpr)
+			if !ok {
+				return nil, fmt.Errorf("type 'tuple' expects a list of types")
+			}
+			elements := make([]*Constraint, 0, len(tuple.Exprs))
+			for _, ee := range tuple.Exprs {
+				element, err := constraintOf(ee)
+				if err != nil {
+					return nil, err
+				}
+				elements = append(elements, element)
+			}
+			return &Constraint{Kind: KindTuple, Elements: elements}, nil
+		case KindObject:
+			object, ok := e.Args[0].(*hclsyntax.ObjectConsExpr)
+			if !ok {
+				return nil, fmt.Errorf("type 'object' expects a map of attributes")
+			}
+			attributes := make([]*Attribute, 0, len(object.Items))
+			for _, item := range object.Items {
+				attribute, err := attributeOf(item)
+				if err != nil {
+					return nil, err
+				}
+				attributes = append(attributes, attribute)
+			}
+			return &Constraint{Kind: KindObject, Attributes: attributes}, nil
+		}
+		return nil, fmt.Errorf("unknown type '%s'", e.Name)
+	}
+	return nil, fmt.Errorf("invalid type constraint")
+}
+
+func attributeOf(item hclsyntax.ObjectConsItem) (*Attribute, error) {
+	name := hcl.ExprAsKeyword(item.KeyExpr)
+	if name == "" {
+		value, diags := item.KeyExpr.Value(nil)
+		if diags.HasErrors() || value.Type() != cty.String {
+			return nil, fmt.Errorf("invalid object attribute name")
+		}
+		name = value.AsString()
+	}
+	expr := item.ValueExpr
+	optional := false
+	if call, ok := expr.(*hclsyntax.FunctionCallExpr); ok && call.Name == "optional" {
+		if len(call.Args) < 1 {
+			return nil, fmt.Errorf("'optional' expects a type")
+		}
+		expr = call.Args[0]
+		optional = true
+	}
+	t, err := constraintOf(expr)
+	if err != nil {
+		return nil, err
+	}
+	return &Attribute{
+		Name:     name,
+		Type:     t,
+		Optional: optional,
+	}, nil
+}
+
+// Placeholder returns an empty value which conforms to type constraint, to
+// be used as the starting point of required variables (e.g. in tfvars). Only
+// non-optional attributes of objects are included.
+func (c *Constraint) Placeholder() interface{} {
+	switch c.Kind {
+	case KindBool:
+		return false
+	case KindNumber:
+		return float64(0)
+	case KindList, KindSet, KindTuple:
+		return []interface{}{}
+	case KindMap:
+		return map[string]interface{}{}
+	case KindObject:
+		placeholder := make(map[string]interface{})
+		for _, a := range c.Attributes {
+			if !a.Optional {
+				placeholder[a.Name] = a.Type.Placeholder()
+			}
+		}
+		return placeholder
+	}
+	return ""
+}
+
+// String returns the canonical representation of type constraint.
+func (c *Constraint) String() string {
+	switch c.Kind {
+	case KindList, KindMap, KindSet:
+		return fmt.Sprintf("%s(%s)", c.Kind, c.Element)
+	case KindTuple:
+		elements := make([]string, 0, len(c.Elements))
+		for _, e := range c.Elements {
+			elements = append(elements, e.String())
+		}
+		return fmt.Sprintf("tuple([%s])", strings.Join(elements, ", "))
+	case KindObject:
+		attributes := make([]string, 0, len(c.Attributes))
+		for _, a := range c.Attributes {
+			if a.Optional {
+				attributes = append(attributes, fmt.Sprintf("%s = optional(%s)", a.Name, a.Type))
+			} else {
+				attributes = append(attributes, fmt.Sprintf("%s = %s", a.Name, a.Type))
+			}
+		}
+		return fmt.Sprintf("object({%s})", strings.Join(attributes, ", "))
+	}
+	return string(c.Kind)
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConstraint(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "empty type",
+			value:    "",
+			expected: "any",
+			wantErr:  false,
+		},
+		{
+			name:     "primitive type",
+			value:    "number",
+			expected: "number",
+			wantErr:  false,
+		},
+		{
+			name:     "legacy quoted type",
+			value:    `"string"`,
+			expected: "string",
+			wantErr:  false,
+		},
+		{
+			name:     "legacy collection type",
+			value:    "list",
+			expected: "list(any)",
+			wantErr:  false,
+		},
+		{
+			name:     "collection type",
+			value:    "map(list(string))",
+			expected: "map(list(string))",
+			wantErr:  false,
+		},
+		{
+			name:     "tuple type",
+			value:    "tuple([string, bool])",
+			expected: "tuple([string, bool])",
+			wantErr:  false,
+		},
+		{
+			name:     "object type",
+			value:    "object({\n  name = string,\n  tags = optional(map(string))\n})",
+			expected: "object({name = string, tags = optional(map(string))})",
+			wantErr:  false,
+		},
+		{
+			name:     "unknown type",
+			value:    "foo",
+			expected: "",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid expression",
+			value:    "list(",
+			expected: "",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual, err := ParseConstraint(tt.value)
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual.String())
+			}
+		})
+	}
+}
+
+func TestParseConstraintObject(t *testing.T) {
+	assert := assert.New(t)
+	actual, err := ParseConstraint("object({ name = string, size = optional(number) })")
+
+	assert.Nil(err)
+	assert.Equal(KindObject, actual.Kind)
+	assert.Equal(2, len(actual.Attributes))
+	assert.Equal("name", actual.Attributes[0].Name)
+	assert.Equal(KindString, actual.Attributes[0].Type.Kind)
+	assert.False(actual.Attributes[0].Optional)
+	assert.Equal("size", actual.Attributes[1].Name)
+	assert.Equal(KindNumber, actual.Attributes[1].Type.Kind)
+	assert.True(actual.Attributes[1].Optional)
+}
+
+func TestConstraintPlaceholder(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{
+			name:     "any type",
+			value:    "any",
+			expected: `""`,
+		},
+		{
+			name:     "string type",
+			value:    "string",
+			expected: `""`,
+		},
+		{
+			name:     "number type",
+			value:    "number",
+			expected: "0",
+		},
+		{
+			name:     "bool type",
+			value:    "bool",
+			expected: "false",
+		},
+		{
+			name:     "collection type",
+			value:    "set(string)",
+			expected: "[]",
+		},
+		{
+			name:     "map type",
+			value:    "map(number)",
+			expected: "{}",
+		},
+		{
+			name:     "object type",
+			value:    "object({ name = string, size = optional(number), tags = map(string) })",
+			expected: "{\n  name = \"\"\n  tags = {}\n}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			constraint, err := ParseConstraint(tt.value)
+
+			assert.Nil(err)
+			assert.Equal(tt.expected, HCL(constraint.Placeholder()))
+		})
+	}
+}
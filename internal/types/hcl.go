@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// HCL returns representation of value 'v' as an HCL expression, the same way
+// 'terraform fmt' would format it. Empty collections and primitive values are
+// rendered in a single line, anything else spans over multiple lines with two
+// spaces of indentation for each level.
+func HCL(v interface{}) string {
+	return hclOf(v, "")
+}
+
+// Expression represents an HCL expression (e.g. a reference or a type
+// constraint) which is rendered as is.
+type Expression string
+
+// HCLBlock returns representation of a block of 'kind' with 'labels' and
+// 'attributes', in the order of 'keys', the same way 'terraform fmt' would
+// format it. Values of attributes are rendered the same as HCL. An empty key
+// adds a blank line, which separates alignment of attributes before and after.
+func HCLBlock(kind string, labels []string, keys []string, attributes map[string]interface{}) string {
+	var b strings.Builder
+	b.WriteString(kind)
+	for _, l := range labels {
+		b.WriteString(" " + hclString(l))
+	}
+	if len(keys) == 0 {
+		b.WriteString(" {}")
+		return b.String()
+	}
+	b.WriteString(" {\n")
+	group := []string{}
+	values := make(map[string]string, len(keys))
+	flush := func() {
+		if len(group) > 0 {
+			object := hclObject(group, values, "")
+			b.WriteString(strings.TrimSuffix(strings.TrimPrefix(object, "{\n"), "}"))
+		}
+		group = []string{}
+	}
+	for _, k := range keys {
+		if k == "" {
+			flush()
+			b.WriteString("\n")
+			continue
+		}
+		values[k] = hclOf(attributes[k], "  ")
+		group = append(group, k)
+	}
+	flush()
+	b.WriteString("}")
+	return b.String()
+}
+
+func hclOf(v interface{}, indent string) string {
+	if expression, ok := v.(Expression); ok {
+		return string(expression)
+	}
+	if value, ok := v.(Value); ok {
+		v = value.Raw()
+	}
+	if v == nil {
+		return "null"
+	}
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.String:
+		return hclString(value.String())
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool())
+	case reflect.Slice, reflect.Array:
+		if value.Len() == 0 {
+			return "[]"
+		}
+		var b strings.Builder
+		b.WriteString("[\n")
+		for i := 0; i < value.Len(); i++ {
+			b.WriteString(indent + "  ")
+			b.WriteString(hclOf(value.Index(i).Interface(), indent+"  "))
+			b.WriteString(",\n")
+		}
+		b.WriteString(indent + "]")
+		return b.String()
+	case reflect.Map:
+		if value.Len() == 0 {
+			return "{}"
+		}
+		keys := make([]string, 0, value.Len())
+		values := make(map[string]string, value.Len())
+		for _, k := range value.MapKeys() {
+			key := fmt.Sprintf("%v", k.Interface())
+			keys = append(keys, key)
+			values[key] = hclOf(value.MapIndex(k).Interface(), indent+"  ")
+		}
+		sort.Strings(keys)
+		return hclObject(keys, values, indent)
+	}
+	return hclString(fmt.Sprintf("%v", v))
+}
+
+// hclObject renders object attributes in the order of 'keys'. Equal signs of
+// consecutive single-line attributes are aligned, a multi-line attribute breaks
+// the alignment.
+func hclObject(keys []string, values map[string]string, indent string) string {
+	names := make([]string, len(keys))
+	padding := make([]int, len(keys))
+	maxlen := 0
+	index := 0
+	for i, k := range keys {
+		names[i] = k
+		if !hclsyntax.ValidIdentifier(k) {
+			names[i] = hclString(k)
+		}
+		if strings.Contains(values[k], "\n") {
+			for j := index; j < i; j++ {
+				padding[j] = maxlen
+			}
+			padding[i] = len(names[i])
+			maxlen = 0
+			index = i + 1
+		} else if len(names[i]) > maxlen {
+			maxlen = len(names[i])
+		}
+	}
+	for i := index; i < len(keys); i++ {
+		padding[i] = maxlen
+	}
+
+	var b strings.Builder
+	b.WriteString("{\n")
+	for i, k := range keys {
+		b.WriteString(fmt.Sprintf("%s  %-*s = %s\n", indent, padding[i], names[i], values[k]))
+	}
+	b.WriteString(indent + "}")
+	return b.String()
+}
+
+// hclString returns quoted representation of 's' with all the special
+// characters, including template sequences, escaped.
+func hclString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i, r := range s {
+		switch r {
+		case '\\':
+			b.WriteString(`\\`)
+		case '"':
+			b.WriteString(`\"`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '$', '%':
+			b.WriteRune(r)
+			if strings.HasPrefix(s[i+1:], "{") {
+				b.WriteRune(r)
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package types
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHCL(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{
+			name:     "nil value",
+			value:    nil,
+			expected: "null",
+		},
+		{
+			name:     "nil type",
+			value:    new(Nil),
+			expected: "null",
+		},
+		{
+			name:     "string value",
+			value:    String("foo"),
+			expected: `"foo"`,
+		},
+		{
+			name:     "string with special characters",
+			value:    "a \"quoted\" \\ ${var} %{if} $5\nnewline",
+			expected: `"a \"quoted\" \\ $${var} %%{if} $5\nnewline"`,
+		},
+		{
+			name:     "empty value",
+			value:    Empty(""),
+			expected: `""`,
+		},
+		{
+			name:     "number value",
+			value:    Number(15.75),
+			expected: "15.75",
+		},
+		{
+			name:     "integer number value",
+			value:    float64(42),
+			expected: "42",
+		},
+		{
+			name:     "bool value",
+			value:    Bool(true),
+			expected: "true",
+		},
+		{
+			name:     "empty list",
+			value:    List{},
+			expected: "[]",
+		},
+		{
+			name:     "list value",
+			value:    List{"a", float64(1), true},
+			expected: "[\n  \"a\",\n  1,\n  true,\n]",
+		},
+		{
+			name:     "expression",
+			value:    Expression("var.foo"),
+			expected: "var.foo",
+		},
+		{
+			name:     "empty map",
+			value:    Map{},
+			expected: "{}",
+		},
+		{
+			name:     "map value",
+			value:    Map{"b": float64(2), "a": float64(1), "long-name": "x"},
+			expected: "{\n  a         = 1\n  b         = 2\n  long-name = \"x\"\n}",
+		},
+		{
+			name:     "map with non identifier keys",
+			value:    Map{"a b": float64(1), "1st": float64(2)},
+			expected: "{\n  \"1st\" = 2\n  \"a b\" = 1\n}",
+		},
+		{
+			name: "nested value",
+			value: Map{
+				"name": "hello",
+				"list": []interface{}{"fizz"},
+				"foo":  map[string]interface{}{"bar": "foo"},
+				"zero": float64(0),
+			},
+			expected: "{\n  foo = {\n    bar = \"foo\"\n  }\n  list = [\n    \"fizz\",\n  ]\n  name = \"hello\"\n  zero = 0\n}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := HCL(tt.value)
+			assert.Equal(tt.expected, actual)
+
+			_, diags := hclsyntax.ParseExpression([]byte(actual), "", hcl.Pos{Line: 1, Column: 1})
+			assert.False(diags.HasErrors())
+		})
+	}
+}
+
+func TestHCLBlock(t *testing.T) {
+	tests := []struct {
+		name       string
+		kind       string
+		labels     []string
+		keys       []string
+		attributes map[string]interface{}
+		expected   string
+	}{
+		{
+			name:       "empty block",
+			kind:       "variable",
+			labels:     []string{"foo"},
+			keys:       []string{},
+			attributes: map[string]interface{}{},
+			expected:   "variable \"foo\" {}",
+		},
+		{
+			name:   "block with attributes",
+			kind:   "variable",
+			labels: []string{"foo"},
+			keys:   []string{"description", "type", "default"},
+			attributes: map[string]interface{}{
+				"description": "a \"quoted\" description",
+				"type":        Expression("list(string)"),
+				"default":     List{"a"},
+			},
+			expected: "variable \"foo\" {\n  description = \"a \\\"quoted\\\" description\"\n  type        = list(string)\n  default = [\n    \"a\",\n  ]\n}",
+		},
+		{
+			name:   "block with blank line",
+			kind:   "module",
+			labels: []string{"foo"},
+			keys:   []string{"source", "", "name", "description"},
+			attributes: map[string]interface{}{
+				"source":      "./foo",
+				"name":        Expression("var.name"),
+				"description": "foo",
+			},
+			expected: "module \"foo\" {\n  source = \"./foo\"\n\n  name        = var.name\n  description = \"foo\"\n}",
+		},
+		{
+			name:   "block with multiple labels",
+			kind:   "resource",
+			labels: []string{"null_resource", "foo"},
+			keys:   []string{"count"},
+			attributes: map[string]interface{}{
+				"count": float64(1),
+			},
+			expected: "resource \"null_resource\" \"foo\" {\n  count = 1\n}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := HCLBlock(tt.kind, tt.labels, tt.keys, tt.attributes)
+			assert.Equal(tt.expected, actual)
+
+			_, diags := hclsyntax.ParseConfig([]byte(actual), "", hcl.Pos{Line: 1, Column: 1})
+			assert.False(diags.HasErrors())
+		})
+	}
+}
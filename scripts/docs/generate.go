@@ -14,6 +14,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
@@ -25,6 +26,7 @@ import (
 	"github.com/terraform-docs/terraform-docs/cmd"
 	"github.com/terraform-docs/terraform-docs/internal/format"
 	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/schema"
 	"github.com/terraform-docs/terraform-docs/internal/terraform"
 )
 
@@ -41,6 +43,25 @@ func main() {
 	if err := generate(cmd.NewCommand(), baseWeight, "terraform-docs"); err != nil {
 		log.Fatal(err)
 	}
+	if err := generateSchemas(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generateSchemas writes JSON Schema document of all the supported versions
+// of the structured outputs into 'docs/schema' folder.
+func generateSchemas() error {
+	for _, version := range schema.Versions() {
+		document, err := schema.Generate(version)
+		if err != nil {
+			return err
+		}
+		filename := filepath.Join("docs", "schema", fmt.Sprintf("v%d.json", version))
+		if err := ioutil.WriteFile(filename, []byte(document+"\n"), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func ignore(cmd *cobra.Command) bool {
@@ -182,6 +203,9 @@ func example(ref *reference) error {
 			Name:     settings.SortByName,
 			Required: settings.SortByRequired,
 		},
+		Usage: &terraform.Usage{
+			Source: "./examples", // not inferred from git, to not change on every release
+		},
 	}
 
 	printer, err := format.Factory(ref.Name, settings)
@@ -0,0 +1,39 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+// Package terraformdocs is the public Go API of terraform-docs, which can be
+// used to load a Terraform module and render it in any of the available formats
+// without shelling out to the terraform-docs binary.
+//
+// # Usage
+//
+//	module, err := terraformdocs.Load("./my-terraform-module", nil)
+//	if err != nil {
+//	    return err
+//	}
+//
+//	settings := terraformdocs.DefaultSettings()
+//	settings.ShowProviders = false
+//
+//	output, err := terraformdocs.Render(module, "markdown table", settings)
+//	if err != nil {
+//	    return err
+//	}
+//
+// # Compatibility
+//
+// The types of this package are owned by it, and not shared with the internals
+// of terraform-docs, which are converted to and from them. The package follows
+// semantic versioning of terraform-docs releases: its exported functions, types
+// and fields of types are not removed or changed in a backward incompatible way
+// within a major version, while new ones (e.g. fields of Module or Settings) may
+// be added in minor versions. The rendered output of the built-in formatters is
+// not part of this guarantee.
+package terraformdocs
@@ -0,0 +1,142 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraformdocs
+
+import (
+	"github.com/terraform-docs/terraform-docs/internal/format"
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+)
+
+// Settings represents all the settings used to render a Module. Settings which
+// are specific to some formatters are ignored by the others.
+type Settings struct {
+	EscapeCharacters    bool              // escape special characters, e.g. '_' in Markdown
+	EscapePipe          bool              // escape pipe character in Markdown
+	IndentLevel         int               // level of headers in AsciiDoc, Markdown and reStructuredText [1-5]
+	OutputValues        bool              // show values of outputs
+	SchemaVersion       int               // version of the schema of JSON, TOML, XML and YAML [1, 2]
+	ShowAnchor          bool              // show anchors of inputs and outputs
+	ShowColor           bool              // colorize output of pretty formatter
+	ShowDataFlow        bool              // show data flow of inputs
+	ShowDiagram         bool              // show Mermaid diagram of the module in Markdown
+	ShowHeader          bool              // show header of the module
+	ShowInputReferences bool              // show references to inputs
+	ShowInputs          bool              // show inputs
+	ShowModuleCalls     bool              // show module calls
+	ShowOutputs         bool              // show outputs
+	ShowOutputSources   bool              // show sources of outputs
+	ShowProviders       bool              // show providers
+	ShowRequired        bool              // show whether inputs are required
+	ShowSensitivity     bool              // show whether outputs are sensitive
+	ShowRequirements    bool              // show requirements
+	ShowResources       bool              // show resources
+	ShowTOC             bool              // show table of contents
+	SortByName          bool              // items are sorted by name
+	SortByRequired      bool              // inputs are sorted by being required
+	SortByType          bool              // inputs are sorted by type
+	Templates           map[string]string // templates overriding the named templates of formatters
+	TfvarsDescription   bool              // show descriptions of inputs as comments in tfvars hcl
+	ValueFormat         string            // format of values of inputs and outputs [json, hcl]
+}
+
+// Formatter is the interface implemented by all the formatters which render
+// a Module in a specific format (e.g. 'json' or 'markdown table').
+type Formatter interface {
+	Print(module *Module, settings *Settings) (string, error)
+}
+
+// DefaultSettings returns new instance of Settings with default values set.
+func DefaultSettings() *Settings {
+	return newSettings(print.DefaultSettings())
+}
+
+// Formatters returns sorted list of names of all the available formatters,
+// including their aliases (e.g. 'md' for 'markdown').
+func Formatters() []string {
+	return format.Names()
+}
+
+// RegisterFormatter registers a custom formatter with 'name', which is then
+// available in NewFormatter and Render, as well as in 'formatter' key of the
+// config file of terraform-docs CLI, exactly like the built-in formatters. The
+// 'fn' is called with the settings of each rendering to initialize the
+// formatter. An error is returned if a formatter with 'name' already exists.
+func RegisterFormatter(name string, fn func(*Settings) Formatter) error {
+	if fn == nil {
+		return format.Register(name, nil)
+	}
+	return format.Register(name, func(settings *print.Settings) (print.Engine, error) {
+		return &engine{formatter: fn(newSettings(settings))}, nil
+	})
+}
+
+// NewFormatter returns the formatter registered with 'name' (e.g. 'json' or
+// 'markdown table') initialized with provided 'settings'. Default settings
+// are used if 'settings' is nil. An error is returned if templates of
+// 'settings' can't be parsed.
+func NewFormatter(name string, settings *Settings) (Formatter, error) {
+	if settings == nil {
+		settings = DefaultSettings()
+	}
+	e, err := format.Factory(name, settings.internal())
+	if err != nil {
+		return nil, err
+	}
+	if e, ok := e.(*engine); ok {
+		return e.formatter, nil
+	}
+	return &formatter{engine: e}, nil
+}
+
+// Render renders 'module' with the formatter registered with 'name' (e.g.
+// 'json' or 'markdown table') and provided 'settings'. Default settings are
+// used if 'settings' is nil.
+func Render(module *Module, name string, settings *Settings) (string, error) {
+	if settings == nil {
+		settings = DefaultSettings()
+	}
+	formatter, err := NewFormatter(name, settings)
+	if err != nil {
+		return "", err
+	}
+	return formatter.Print(module, settings)
+}
+
+// newSettings returns Settings of internal 'settings', which have the same
+// fields.
+func newSettings(settings *print.Settings) *Settings {
+	s := Settings(*settings)
+	return &s
+}
+
+func (s *Settings) internal() *print.Settings {
+	settings := print.Settings(*s)
+	return &settings
+}
+
+// formatter is a built-in formatter, which renders the internal module.
+type formatter struct {
+	engine print.Engine
+}
+
+func (f *formatter) Print(module *Module, settings *Settings) (string, error) {
+	return f.engine.Print(module.internal(settings), settings.internal())
+}
+
+// engine is a custom formatter, which is registered among the built-in ones.
+type engine struct {
+	formatter Formatter
+}
+
+func (e *engine) Print(module *terraform.Module, settings *print.Settings) (string, error) {
+	return e.formatter.Print(newModule(module), newSettings(settings))
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraformdocs
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/format"
+	"github.com/terraform-docs/terraform-docs/internal/print"
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+)
+
+func TestFormatters(t *testing.T) {
+	assert := assert.New(t)
+	formatters := Formatters()
+
+	assert.Contains(formatters, "json")
+	assert.Contains(formatters, "markdown table")
+	assert.Contains(formatters, "tfvars hcl")
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name      string
+		formatter string
+		settings  *Settings
+		wantErr   bool
+	}{
+		{
+			name:      "render module with default settings",
+			formatter: "markdown table",
+			settings:  nil,
+			wantErr:   false,
+		},
+		{
+			name:      "render module with provided settings",
+			formatter: "json",
+			settings:  &Settings{ShowInputs: true, SchemaVersion: 2},
+			wantErr:   false,
+		},
+		{
+			name:      "render module with unknown formatter",
+			formatter: "unknown",
+			settings:  nil,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			module, err := Load(filepath.Join("..", "examples"), nil)
+			assert.Nil(err)
+
+			actual, err := Render(module, tt.formatter, tt.settings)
+			if tt.wantErr {
+				assert.NotNil(err)
+				return
+			}
+			assert.Nil(err)
+
+			formatter, err := NewFormatter(tt.formatter, tt.settings)
+			assert.Nil(err)
+
+			settings := tt.settings
+			if settings == nil {
+				settings = DefaultSettings()
+			}
+			expected, err := formatter.Print(module, settings)
+			assert.Nil(err)
+			assert.Equal(expected, actual)
+		})
+	}
+}
+
+type countFormatter struct {
+	prefix string
+}
+
+func (c *countFormatter) Print(module *Module, settings *Settings) (string, error) {
+	return fmt.Sprintf("%s%d", c.prefix, len(module.Inputs)), nil
+}
+
+func TestRegisterFormatter(t *testing.T) {
+	assert := assert.New(t)
+
+	err := RegisterFormatter("count inputs", func(settings *Settings) Formatter {
+		return &countFormatter{prefix: "inputs: "}
+	})
+	assert.Nil(err)
+	t.Cleanup(func() { format.Unregister("count inputs") })
+	assert.Contains(Formatters(), "count inputs")
+
+	module, err := Load(filepath.Join("..", "examples"), nil)
+	assert.Nil(err)
+
+	actual, err := Render(module, "count inputs", nil)
+	assert.Nil(err)
+	assert.Equal("inputs: 31", actual)
+
+	err = RegisterFormatter("json", func(settings *Settings) Formatter {
+		return &countFormatter{}
+	})
+	assert.NotNil(err)
+}
+
+func TestRegisterFormatterCleanup(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("register", func(t *testing.T) {
+		err := RegisterFormatter("count inputs", func(settings *Settings) Formatter {
+			return &countFormatter{}
+		})
+		assert.Nil(err)
+		t.Cleanup(func() { format.Unregister("count inputs") })
+	})
+	assert.NotContains(Formatters(), "count inputs")
+}
+
+func TestRenderSameAsInternal(t *testing.T) {
+	options := terraform.NewOptions()
+	options.Path = filepath.Join("..", "examples")
+	options.OutputValues = true
+	options.OutputValuesPath = filepath.Join("..", "examples", "output_values.json")
+	options.DataFlow = true
+	options.OutputSources = true
+	options.InputReferences = true
+	options.ShowUsage = true
+	options.Usage.Source = "./examples"
+
+	for _, name := range []string{"asciidoc document", "json", "markdown table", "tfvars hcl", "xml", "yaml"} {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			settings := print.DefaultSettings()
+			settings.OutputValues = true
+			settings.ShowDataFlow = true
+			settings.ShowOutputSources = true
+			settings.ShowInputReferences = true
+
+			internal, err := terraform.LoadWithOptions(options)
+			assert.Nil(err)
+			engine, err := format.Factory(name, settings)
+			assert.Nil(err)
+			expected, err := engine.Print(internal, settings)
+			assert.Nil(err)
+
+			module, err := Load(options.Path, &Options{
+				ShowHeader:       true,
+				HeaderFromFile:   "main.tf",
+				OutputValues:     true,
+				OutputValuesPath: options.OutputValuesPath,
+				ShowUsage:        true,
+				Usage:            &Usage{Source: "./examples"},
+				DataFlow:         true,
+				OutputSources:    true,
+				InputReferences:  true,
+			})
+			assert.Nil(err)
+			actual, err := Render(module, name, newSettings(settings))
+			assert.Nil(err)
+			assert.Equal(expected, actual)
+		})
+	}
+}
+
+func TestRenderModifiedModule(t *testing.T) {
+	assert := assert.New(t)
+
+	module, err := Load(filepath.Join("..", "examples"), nil)
+	assert.Nil(err)
+	module.Inputs = module.Inputs[:1]
+	module.Inputs[0].Description = "Modified description."
+
+	actual, err := Render(module, "json", nil)
+	assert.Nil(err)
+	assert.Contains(actual, `"description": "Modified description."`)
+	assert.NotContains(actual, `"name": "bool-1"`)
+}
@@ -0,0 +1,335 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraformdocs
+
+import (
+	"github.com/terraform-docs/terraform-docs/internal/terraform"
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// Module represents a loaded Terraform module with its header, inputs, module
+// calls, outputs, providers, requirements and resources.
+type Module struct {
+	Header       string
+	Inputs       []*Input
+	ModuleCalls  []*ModuleCall
+	Outputs      []*Output
+	Providers    []*Provider
+	Requirements []*Requirement
+	Resources    []*Resource
+	DataFlow     []*DataFlow
+	Usage        string
+
+	loaded *terraform.Module // module as loaded, to keep its path and usage options
+}
+
+// Input represents a Terraform input variable. Default holds the value as
+// decoded from JSON, i.e. nil, bool, float64, string, []interface{} or
+// map[string]interface{}.
+type Input struct {
+	Name        string
+	Type        string
+	Description string
+	Default     interface{}
+	Required    bool
+	References  []*Reference
+	Unused      bool
+	Position    Position
+}
+
+// ModuleCall represents a submodule called by Terraform module.
+type ModuleCall struct {
+	Name    string
+	Source  string
+	Version string
+}
+
+// Output represents a Terraform output. Value holds the value as decoded from
+// JSON, the same as Default of Input, and it's only set if values of outputs
+// are loaded.
+type Output struct {
+	Name        string
+	Description string
+	Value       interface{}
+	Sensitive   bool
+	Sources     []string
+	Position    Position
+}
+
+// Provider represents a Terraform provider.
+type Provider struct {
+	Name     string
+	Alias    string
+	Version  string
+	Position Position
+}
+
+// Requirement represents a requirement for Terraform module.
+type Requirement struct {
+	Name    string
+	Version string
+}
+
+// Resource represents a managed or data type that is created by the module.
+// Names are the names of the blocks declaring resources of the type.
+type Resource struct {
+	Type           string
+	ProviderName   string
+	ProviderSource string
+	Mode           string
+	Version        string
+	Names          []string
+}
+
+// DataFlow represents a reference from an item of a Terraform module to another
+// one, i.e. data flowing from 'From' to 'To'.
+type DataFlow struct {
+	From string
+	To   string
+}
+
+// Reference represents a reference to an input from an item of a Terraform module.
+type Reference struct {
+	Address  string
+	Filename string
+	Line     int
+}
+
+// Position represents position of an item (e.g. input) in the files of a Terraform module.
+type Position struct {
+	Filename string
+	Line     int
+}
+
+// Options represents all options to load a Terraform module.
+type Options struct {
+	ShowHeader       bool
+	HeaderFromFile   string
+	SortBy           *SortBy
+	OutputValues     bool
+	OutputValuesPath string
+	ShowUsage        bool
+	Usage            *Usage
+	DataFlow         bool
+	OutputSources    bool
+	InputReferences  bool
+}
+
+// SortBy contains different sort criteria of items of a Terraform module.
+type SortBy struct {
+	Name     bool
+	Required bool
+	Type     bool
+}
+
+// Usage contains the options of the usage of a Terraform module, i.e. the
+// 'module' block calling it.
+type Usage struct {
+	Name     string
+	Source   string
+	Version  string
+	Optional bool
+}
+
+// NewOptions returns new instance of Options with default values set.
+func NewOptions() *Options {
+	options := terraform.NewOptions()
+	return &Options{
+		ShowHeader:       options.ShowHeader,
+		HeaderFromFile:   options.HeaderFromFile,
+		SortBy:           &SortBy{},
+		OutputValues:     options.OutputValues,
+		OutputValuesPath: options.OutputValuesPath,
+		ShowUsage:        options.ShowUsage,
+		Usage:            &Usage{},
+		DataFlow:         options.DataFlow,
+		OutputSources:    options.OutputSources,
+		InputReferences:  options.InputReferences,
+	}
+}
+
+// Load loads the Terraform module located at 'path' with provided 'options'.
+// Default options are used if 'options' is nil. Provided 'options' are not
+// modified.
+func Load(path string, options *Options) (*Module, error) {
+	if options == nil {
+		options = NewOptions()
+	}
+	module, err := terraform.LoadWithOptions(options.internal(path))
+	if err != nil {
+		return nil, err
+	}
+	return newModule(module), nil
+}
+
+func (o *Options) internal(path string) *terraform.Options {
+	options := &terraform.Options{
+		Path:             path,
+		ShowHeader:       o.ShowHeader,
+		HeaderFromFile:   o.HeaderFromFile,
+		SortBy:           &terraform.SortBy{},
+		OutputValues:     o.OutputValues,
+		OutputValuesPath: o.OutputValuesPath,
+		ShowUsage:        o.ShowUsage,
+		DataFlow:         o.DataFlow,
+		OutputSources:    o.OutputSources,
+		InputReferences:  o.InputReferences,
+	}
+	if o.SortBy != nil {
+		options.SortBy = &terraform.SortBy{Name: o.SortBy.Name, Required: o.SortBy.Required, Type: o.SortBy.Type}
+	}
+	if o.Usage != nil {
+		options.Usage = &terraform.Usage{Name: o.Usage.Name, Source: o.Usage.Source, Version: o.Usage.Version, Optional: o.Usage.Optional}
+	}
+	return options
+}
+
+// newModule returns Module of the loaded 'module'.
+func newModule(module *terraform.Module) *Module {
+	m := &Module{
+		Header:       module.Header,
+		Inputs:       make([]*Input, 0, len(module.Inputs)),
+		ModuleCalls:  make([]*ModuleCall, 0, len(module.ModuleCalls)),
+		Outputs:      make([]*Output, 0, len(module.Outputs)),
+		Providers:    make([]*Provider, 0, len(module.Providers)),
+		Requirements: make([]*Requirement, 0, len(module.Requirements)),
+		Resources:    make([]*Resource, 0, len(module.Resources)),
+		Usage:        module.Usage,
+		loaded:       module,
+	}
+	for _, i := range module.Inputs {
+		input := &Input{
+			Name:        i.Name,
+			Type:        string(i.Type),
+			Description: string(i.Description),
+			Default:     i.Default.Raw(),
+			Required:    i.Required,
+			Unused:      i.Unused,
+			Position:    Position(i.Position),
+		}
+		if i.References != nil {
+			input.References = make([]*Reference, 0, len(i.References))
+			for _, r := range i.References {
+				input.References = append(input.References, &Reference{Address: r.Address, Filename: r.Filename, Line: r.Line})
+			}
+		}
+		m.Inputs = append(m.Inputs, input)
+	}
+	for _, c := range module.ModuleCalls {
+		m.ModuleCalls = append(m.ModuleCalls, &ModuleCall{Name: c.Name, Source: c.Source, Version: c.Version})
+	}
+	for _, o := range module.Outputs {
+		output := &Output{
+			Name:        o.Name,
+			Description: string(o.Description),
+			Sensitive:   o.Sensitive,
+			Sources:     o.Sources,
+			Position:    Position(o.Position),
+		}
+		if o.Value != nil {
+			output.Value = o.Value.Raw()
+		}
+		m.Outputs = append(m.Outputs, output)
+	}
+	for _, p := range module.Providers {
+		m.Providers = append(m.Providers, &Provider{Name: p.Name, Alias: string(p.Alias), Version: string(p.Version), Position: Position(p.Position)})
+	}
+	for _, r := range module.Requirements {
+		m.Requirements = append(m.Requirements, &Requirement{Name: r.Name, Version: string(r.Version)})
+	}
+	for _, r := range module.Resources {
+		m.Resources = append(m.Resources, &Resource{Type: r.Type, ProviderName: r.ProviderName, ProviderSource: r.ProviderSource, Mode: r.Mode, Version: string(r.Version), Names: r.Names})
+	}
+	if module.DataFlow != nil {
+		m.DataFlow = make([]*DataFlow, 0, len(module.DataFlow))
+		for _, f := range module.DataFlow {
+			m.DataFlow = append(m.DataFlow, &DataFlow{From: f.From, To: f.To})
+		}
+	}
+	return m
+}
+
+// internal returns the module to be rendered by the formatters, with the
+// current fields of 'm'. Values of outputs are shown if it's set in 'settings'.
+func (m *Module) internal(settings *Settings) *terraform.Module {
+	module := &terraform.Module{}
+	if m.loaded != nil {
+		copy := *m.loaded
+		module = &copy
+	}
+	module.Header = m.Header
+	module.Usage = m.Usage
+	module.Inputs = make([]*terraform.Input, 0, len(m.Inputs))
+	module.RequiredInputs = []*terraform.Input{}
+	module.OptionalInputs = []*terraform.Input{}
+	for _, i := range m.Inputs {
+		input := &terraform.Input{
+			Name:        i.Name,
+			Type:        types.String(i.Type),
+			Description: types.String(i.Description),
+			Default:     types.ValueOf(i.Default),
+			Required:    i.Required,
+			Unused:      i.Unused,
+			Position:    terraform.Position(i.Position),
+		}
+		if i.References != nil {
+			input.References = make([]*terraform.Reference, 0, len(i.References))
+			for _, r := range i.References {
+				input.References = append(input.References, &terraform.Reference{Address: r.Address, Filename: r.Filename, Line: r.Line})
+			}
+		}
+		module.Inputs = append(module.Inputs, input)
+		if input.Required {
+			module.RequiredInputs = append(module.RequiredInputs, input)
+		} else {
+			module.OptionalInputs = append(module.OptionalInputs, input)
+		}
+	}
+	module.ModuleCalls = make([]*terraform.ModuleCall, 0, len(m.ModuleCalls))
+	for _, c := range m.ModuleCalls {
+		module.ModuleCalls = append(module.ModuleCalls, &terraform.ModuleCall{Name: c.Name, Source: c.Source, Version: c.Version})
+	}
+	module.Outputs = make([]*terraform.Output, 0, len(m.Outputs))
+	for _, o := range m.Outputs {
+		output := &terraform.Output{
+			Name:        o.Name,
+			Description: types.String(o.Description),
+			Sensitive:   o.Sensitive,
+			Sources:     o.Sources,
+			Position:    terraform.Position(o.Position),
+			ShowValue:   settings.OutputValues,
+		}
+		if settings.OutputValues {
+			output.Value = types.ValueOf(o.Value)
+		}
+		module.Outputs = append(module.Outputs, output)
+	}
+	module.Providers = make([]*terraform.Provider, 0, len(m.Providers))
+	for _, p := range m.Providers {
+		module.Providers = append(module.Providers, &terraform.Provider{Name: p.Name, Alias: types.String(p.Alias), Version: types.String(p.Version), Position: terraform.Position(p.Position)})
+	}
+	module.Requirements = make([]*terraform.Requirement, 0, len(m.Requirements))
+	for _, r := range m.Requirements {
+		module.Requirements = append(module.Requirements, &terraform.Requirement{Name: r.Name, Version: types.String(r.Version)})
+	}
+	module.Resources = make([]*terraform.Resource, 0, len(m.Resources))
+	for _, r := range m.Resources {
+		module.Resources = append(module.Resources, &terraform.Resource{Type: r.Type, ProviderName: r.ProviderName, ProviderSource: r.ProviderSource, Mode: r.Mode, Version: types.String(r.Version), Names: r.Names})
+	}
+	module.DataFlow = nil
+	if m.DataFlow != nil {
+		module.DataFlow = make([]*terraform.DataFlow, 0, len(m.DataFlow))
+		for _, f := range m.DataFlow {
+			module.DataFlow = append(module.DataFlow, &terraform.DataFlow{From: f.From, To: f.To})
+		}
+	}
+	return module
+}
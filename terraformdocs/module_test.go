@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The terraform-docs Authors.
+
+Licensed under the MIT license (the "License"); you may not
+use this file except in compliance with the License.
+
+You may obtain a copy of the License at the LICENSE file in
+the root directory of this source tree.
+*/
+
+package terraformdocs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		options *Options
+		inputs  int
+		wantErr bool
+	}{
+		{
+			name:    "load module with default options",
+			path:    filepath.Join("..", "examples"),
+			options: nil,
+			inputs:  31,
+			wantErr: false,
+		},
+		{
+			name:    "load module with provided options",
+			path:    filepath.Join("..", "examples"),
+			options: &Options{ShowHeader: false},
+			inputs:  31,
+			wantErr: false,
+		},
+		{
+			name:    "load module from non-existing path",
+			path:    filepath.Join("..", "noop"),
+			options: nil,
+			inputs:  0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			module, err := Load(tt.path, tt.options)
+			if tt.wantErr {
+				assert.NotNil(err)
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(tt.inputs, len(module.Inputs))
+			if tt.options != nil {
+				assert.Equal("", module.Header)
+			} else {
+				assert.NotEqual("", module.Header)
+			}
+		})
+	}
+}
+
+func TestLoadSorted(t *testing.T) {
+	assert := assert.New(t)
+	options := NewOptions()
+	options.SortBy.Name = true
+
+	module, err := Load(filepath.Join("..", "examples"), options)
+
+	assert.Nil(err)
+	assert.Equal("bool-1", module.Inputs[0].Name)
+}
+
+func TestLoadFieldTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	module, err := Load(filepath.Join("..", "examples"), nil)
+	assert.Nil(err)
+
+	inputs := make(map[string]*Input)
+	for _, i := range module.Inputs {
+		inputs[i.Name] = i
+	}
+
+	assert.Equal("It's string number two.", inputs["string-2"].Description)
+	assert.Equal([]interface{}{}, inputs["list-3"].Default)
+	assert.Nil(inputs["string-2"].Default)
+
+	position := inputs["list-3"].Position
+	assert.Equal("variables.tf", filepath.Base(position.Filename))
+	assert.Equal(75, position.Line)
+}